@@ -0,0 +1,153 @@
+package godash
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Cache is a bounded key-value store, implemented by [LRUCache] and [LFUCache].
+// Use [NewSyncCache] to share a Cache between goroutines.
+type Cache[K comparable, V any] interface {
+	// Get returns the value cached for the key, counting it as a use of the entry.
+	// If the key isn't cached or its entry expired, it returns the zero value of type `V` and `false`.
+	Get(key K) (V, bool)
+	// Peek behaves like Get, except it doesn't count as a use of the entry.
+	Peek(key K) (V, bool)
+	// Put caches the value for the key, with the default TTL of the cache, replacing any previous value.
+	Put(key K, value V)
+	// PutWithTTL behaves like Put, except the entry expires after ttl. Zero or a negative ttl means
+	// the entry never expires.
+	PutWithTTL(key K, value V, ttl time.Duration)
+	// Remove deletes the entry of the key, returning whether it was cached.
+	Remove(key K) bool
+	// Len returns the number of entries that didn't expire.
+	Len() int
+	// Keys returns the keys of the entries that didn't expire, starting from the one that would be evicted last.
+	Keys() []K
+	// Clear deletes every entry.
+	Clear()
+}
+
+// EvictionReason tells why an entry was removed from a [Cache] without being asked to.
+type EvictionReason int
+
+const (
+	// EvictedByCapacity means the entry was removed to make room for a new one.
+	EvictedByCapacity EvictionReason = iota
+	// EvictedByExpiration means the entry was removed because its TTL elapsed.
+	EvictedByExpiration
+)
+
+// String returns the name of the reason.
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedByCapacity:
+		return "Capacity"
+	case EvictedByExpiration:
+		return "Expiration"
+	default:
+		return fmt.Sprintf("EvictionReason(%d)", int(r))
+	}
+}
+
+// CacheOptions configures an [LRUCache] or an [LFUCache].
+// The zero value is a cache whose entries never expire and that doesn't report evictions.
+type CacheOptions[K comparable, V any] struct {
+	// TTL is how long the entries added by Put stay cached. Zero or a negative value means they never expire.
+	TTL time.Duration
+	// Clock tells the time used to expire entries. If nil, the system clock is used.
+	Clock Clock
+	// OnEvict is called for every entry removed because of the capacity or of its TTL. It isn't called for
+	// entries deleted by Remove or Clear, nor for replaced values. It runs while the cache is being changed,
+	// so it must not call the cache.
+	OnEvict func(key K, value V, reason EvictionReason)
+}
+
+// cacheEntry is an entry of an LRUCache or an LFUCache.
+type cacheEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time // zero if the entry never expires
+}
+
+// expired checks whether the entry expired at the given time.
+func (e *cacheEntry[K, V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// expiration returns when an entry added at the current time of the clock with the given ttl expires.
+func expiration(clock Clock, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return clock.Now().Add(ttl)
+}
+
+// SyncCache wraps a [Cache] guarding every call with a mutex, so it can be shared between goroutines.
+// It is created by [NewSyncCache].
+type SyncCache[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+}
+
+// NewSyncCache wraps the cache so it is safe for concurrent use. The cache must not be used directly afterward.
+func NewSyncCache[K comparable, V any](cache Cache[K, V]) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: cache}
+}
+
+// Get behaves like the Get method of the wrapped cache.
+func (c *SyncCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+// Peek behaves like the Peek method of the wrapped cache.
+func (c *SyncCache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Peek(key)
+}
+
+// Put behaves like the Put method of the wrapped cache.
+func (c *SyncCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Put(key, value)
+}
+
+// PutWithTTL behaves like the PutWithTTL method of the wrapped cache.
+func (c *SyncCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.PutWithTTL(key, value, ttl)
+}
+
+// Remove behaves like the Remove method of the wrapped cache.
+func (c *SyncCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Remove(key)
+}
+
+// Len behaves like the Len method of the wrapped cache.
+func (c *SyncCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// Keys behaves like the Keys method of the wrapped cache.
+func (c *SyncCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Keys()
+}
+
+// Clear behaves like the Clear method of the wrapped cache.
+func (c *SyncCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Clear()
+}
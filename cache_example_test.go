@@ -0,0 +1,42 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+	"time"
+)
+
+func ExampleNewLRUCache() {
+	cache := godash.NewLRUCache(2, godash.CacheOptions[string, int]{
+		OnEvict: func(key string, value int, reason godash.EvictionReason) {
+			fmt.Printf("evicted %s=%d (%v)\n", key, value, reason)
+		},
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Put("c", 3)
+
+	fmt.Println(cache.Keys())
+	// Output:
+	// evicted b=2 (Capacity)
+	// [c a]
+}
+
+func ExampleNewLFUCache() {
+	clock := godash.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := godash.NewLFUCache(10, godash.CacheOptions[string, string]{Clock: clock})
+
+	cache.Put("home", "/")
+	cache.PutWithTTL("session", "abc123", time.Minute)
+	cache.Get("session")
+	fmt.Println(cache.Keys())
+
+	clock.Advance(time.Minute)
+	_, ok := cache.Get("session")
+	fmt.Println(ok, cache.Keys())
+	// Output:
+	// [session home]
+	// false [home]
+}
@@ -0,0 +1,336 @@
+package godash
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// cacheModel is a naive implementation of the LRU and LFU eviction policies, used as a reference to check
+// the caches against.
+type cacheModel struct {
+	lfu       bool
+	capacity  int
+	now       time.Duration
+	tick      int
+	entries   []*cacheModelEntry
+	evictions []string
+}
+
+type cacheModelEntry struct {
+	key       string
+	value     int
+	expiresAt time.Duration // zero if the entry never expires
+	uses      int
+	lastUse   int
+}
+
+// byEvictionOrder returns the entries sorted from the first to the last to be evicted.
+func (m *cacheModel) byEvictionOrder() []*cacheModelEntry {
+	entries := slices.Clone(m.entries)
+	slices.SortFunc(entries, func(a, b *cacheModelEntry) int {
+		if m.lfu && a.uses != b.uses {
+			return a.uses - b.uses
+		}
+		return a.lastUse - b.lastUse
+	})
+	return entries
+}
+
+func (m *cacheModel) expired(e *cacheModelEntry) bool {
+	return e.expiresAt != 0 && m.now >= e.expiresAt
+}
+
+func (m *cacheModel) evict(e *cacheModelEntry, reason EvictionReason) {
+	m.delete(e)
+	m.evictions = append(m.evictions, fmt.Sprintf("%s=%d:%v", e.key, e.value, reason))
+}
+
+func (m *cacheModel) delete(e *cacheModelEntry) {
+	m.entries = slices.DeleteFunc(m.entries, func(other *cacheModelEntry) bool { return other == e })
+}
+
+func (m *cacheModel) live(key string) *cacheModelEntry {
+	for _, e := range m.entries {
+		if e.key == key {
+			if m.expired(e) {
+				m.evict(e, EvictedByExpiration)
+				return nil
+			}
+			return e
+		}
+	}
+	return nil
+}
+
+func (m *cacheModel) use(e *cacheModelEntry) {
+	m.tick++
+	e.uses++
+	e.lastUse = m.tick
+}
+
+func (m *cacheModel) Get(key string) (int, bool) {
+	e := m.live(key)
+	if e == nil {
+		return 0, false
+	}
+	m.use(e)
+	return e.value, true
+}
+
+func (m *cacheModel) Peek(key string) (int, bool) {
+	for _, e := range m.entries {
+		if e.key == key && !m.expired(e) {
+			return e.value, true
+		}
+	}
+	return 0, false
+}
+
+func (m *cacheModel) PutWithTTL(key string, value int, ttl time.Duration) {
+	var expiresAt time.Duration
+	if ttl > 0 {
+		expiresAt = m.now + ttl
+	}
+	if e := m.live(key); e != nil {
+		e.value, e.expiresAt = value, expiresAt
+		m.use(e)
+		return
+	}
+	if len(m.entries) >= m.capacity {
+		m.removeExpired()
+	}
+	if len(m.entries) >= m.capacity {
+		m.evict(m.byEvictionOrder()[0], EvictedByCapacity)
+	}
+	e := &cacheModelEntry{key: key, value: value, expiresAt: expiresAt}
+	m.use(e)
+	m.entries = append(m.entries, e)
+}
+
+func (m *cacheModel) Remove(key string) bool {
+	e := m.live(key)
+	if e != nil {
+		m.delete(e)
+	}
+	return e != nil
+}
+
+func (m *cacheModel) Keys() []string {
+	m.removeExpired()
+	return Reverse(MustMap(m.byEvictionOrder(), func(e *cacheModelEntry) string { return e.key }))
+}
+
+func (m *cacheModel) removeExpired() {
+	for _, e := range m.byEvictionOrder() {
+		if m.expired(e) {
+			m.evict(e, EvictedByExpiration)
+		}
+	}
+}
+
+// cacheOp is an operation applied both to a cache and to its model, returning a description of its outcome.
+type cacheOp struct {
+	name  string
+	apply func(value int, cache Cache[string, int], clock *FakeClock) string
+}
+
+var cacheOps = []cacheOp{
+	{"Put(a)", func(value int, c Cache[string, int], _ *FakeClock) string { c.Put("a", value); return "" }},
+	{"Put(b)", func(value int, c Cache[string, int], _ *FakeClock) string { c.Put("b", value); return "" }},
+	{"Put(c)", func(value int, c Cache[string, int], _ *FakeClock) string { c.Put("c", value); return "" }},
+	{"PutWithTTL(a, 2s)", func(value int, c Cache[string, int], _ *FakeClock) string {
+		c.PutWithTTL("a", value, 2*time.Second)
+		return ""
+	}},
+	{"PutWithTTL(b, 1s)", func(value int, c Cache[string, int], _ *FakeClock) string {
+		c.PutWithTTL("b", value, time.Second)
+		return ""
+	}},
+	{"Get(a)", func(_ int, c Cache[string, int], _ *FakeClock) string { return fmt.Sprint(c.Get("a")) }},
+	{"Get(b)", func(_ int, c Cache[string, int], _ *FakeClock) string { return fmt.Sprint(c.Get("b")) }},
+	{"Peek(a)", func(_ int, c Cache[string, int], _ *FakeClock) string { return fmt.Sprint(c.Peek("a")) }},
+	{"Remove(b)", func(_ int, c Cache[string, int], _ *FakeClock) string { return fmt.Sprint(c.Remove("b")) }},
+	{"Keys()", func(_ int, c Cache[string, int], _ *FakeClock) string { return fmt.Sprint(c.Keys()) }},
+	{"Advance(1s)", func(_ int, _ Cache[string, int], clock *FakeClock) string {
+		clock.Advance(time.Second)
+		return ""
+	}},
+}
+
+// applyToModel runs the operation named op against the model, returning the same outcome as op.apply.
+func applyToModel(op cacheOp, value int, m *cacheModel) string {
+	switch op.name {
+	case "Put(a)":
+		m.PutWithTTL("a", value, 0)
+	case "Put(b)":
+		m.PutWithTTL("b", value, 0)
+	case "Put(c)":
+		m.PutWithTTL("c", value, 0)
+	case "PutWithTTL(a, 2s)":
+		m.PutWithTTL("a", value, 2*time.Second)
+	case "PutWithTTL(b, 1s)":
+		m.PutWithTTL("b", value, time.Second)
+	case "Get(a)":
+		return fmt.Sprint(m.Get("a"))
+	case "Get(b)":
+		return fmt.Sprint(m.Get("b"))
+	case "Peek(a)":
+		return fmt.Sprint(m.Peek("a"))
+	case "Remove(b)":
+		return fmt.Sprint(m.Remove("b"))
+	case "Keys()":
+		return fmt.Sprint(m.Keys())
+	case "Advance(1s)":
+		m.now += time.Second
+	}
+	return ""
+}
+
+// TestCaches_EvictionOrder checks every sequence of up to 5 operations against the reference model.
+func TestCaches_EvictionOrder(t *testing.T) {
+	const capacity, length = 2, 5
+
+	newCaches := map[string]func(CacheOptions[string, int]) Cache[string, int]{
+		"LRU": func(o CacheOptions[string, int]) Cache[string, int] { return NewLRUCache(capacity, o) },
+		"LFU": func(o CacheOptions[string, int]) Cache[string, int] { return NewLFUCache(capacity, o) },
+	}
+
+	for name, newCache := range newCaches {
+		t.Run(name, func(t *testing.T) {
+			sequence := make([]int, length)
+			var check func(depth int) bool
+			check = func(depth int) bool {
+				if depth == length {
+					return checkCacheSequence(t, name == "LFU", capacity, sequence, newCache)
+				}
+				for i := range cacheOps {
+					sequence[depth] = i
+					if !check(depth + 1) {
+						return false
+					}
+				}
+				return true
+			}
+			check(0)
+		})
+	}
+}
+
+func checkCacheSequence(
+	t *testing.T, lfu bool, capacity int, sequence []int, newCache func(CacheOptions[string, int]) Cache[string, int],
+) bool {
+	t.Helper()
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	evictions := make([]string, 0)
+	cache := newCache(CacheOptions[string, int]{
+		Clock: clock,
+		OnEvict: func(key string, value int, reason EvictionReason) {
+			evictions = append(evictions, fmt.Sprintf("%s=%d:%v", key, value, reason))
+		},
+	})
+	model := &cacheModel{lfu: lfu, capacity: capacity, evictions: make([]string, 0)}
+
+	names := make([]string, 0, len(sequence)+1)
+	keys := slices.IndexFunc(cacheOps, func(op cacheOp) bool { return op.name == "Keys()" })
+	for value, i := range append(slices.Clone(sequence), keys) { // ends checking the keys
+		op := cacheOps[i]
+		names = append(names, op.name)
+		got, want := op.apply(value, cache, clock), applyToModel(op, value, model)
+		if got != want || !slices.Equal(evictions, model.evictions) {
+			t.Errorf("after %s: got %q with evictions %v, want %q with evictions %v",
+				strings.Join(names, ", "), got, evictions, want, model.evictions)
+			return false
+		}
+	}
+	return true
+}
+
+func TestCaches_EvictExpiredBeforeCapacity(t *testing.T) {
+	newCaches := map[string]func(CacheOptions[string, int]) Cache[string, int]{
+		"LRU": func(o CacheOptions[string, int]) Cache[string, int] { return NewLRUCache(3, o) },
+		"LFU": func(o CacheOptions[string, int]) Cache[string, int] { return NewLFUCache(3, o) },
+	}
+
+	for name, newCache := range newCaches {
+		t.Run(name, func(t *testing.T) {
+			clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			var evictions []string
+			cache := newCache(CacheOptions[string, int]{
+				Clock: clock,
+				OnEvict: func(key string, value int, reason EvictionReason) {
+					evictions = append(evictions, fmt.Sprintf("%s:%v", key, reason))
+				},
+			})
+			// The expired entry is neither the least recently nor the least frequently used one.
+			cache.PutWithTTL("old", 1, time.Hour)
+			cache.PutWithTTL("short", 2, time.Second)
+			cache.PutWithTTL("new", 3, time.Hour)
+			cache.Get("short")
+			clock.Advance(time.Minute)
+
+			cache.Put("next", 4)
+			if want := []string{"short:Expiration"}; !slices.Equal(evictions, want) {
+				t.Errorf("evictions = %v, want %v", evictions, want)
+			}
+			if _, ok := cache.Peek("old"); !ok || cache.Len() != 3 {
+				t.Errorf("the live entries weren't kept: %v", cache.Keys())
+			}
+
+			cache.Put("last", 5)
+			if want := []string{"short:Expiration", "old:Capacity"}; !slices.Equal(evictions, want) {
+				t.Errorf("evictions = %v, want %v", evictions, want)
+			}
+		})
+	}
+}
+
+func TestEvictionReason_String(t *testing.T) {
+	tests := map[EvictionReason]string{
+		EvictedByCapacity:   "Capacity",
+		EvictedByExpiration: "Expiration",
+		EvictionReason(7):   "EvictionReason(7)",
+	}
+	for reason, want := range tests {
+		if got := reason.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestSyncCache(t *testing.T) {
+	cache := NewSyncCache[int, int](NewLRUCache[int, int](50, CacheOptions[int, int]{}))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				cache.Put(g*100+i, i)
+				cache.Get(g*100 + i/2)
+				cache.Peek(i)
+				cache.Keys()
+				if i%10 == 0 {
+					cache.Remove(g*100 + i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if got := cache.Len(); got != 50 {
+		t.Errorf("Len() = %d, want 50", got)
+	}
+	cache.PutWithTTL(-1, -1, time.Hour)
+	if v, ok := cache.Get(-1); v != -1 || !ok {
+		t.Errorf("Get(-1) = %d, %v, want -1, true", v, ok)
+	}
+	cache.Clear()
+	if got := cache.Len(); got != 0 {
+		t.Errorf("Len() = %d after Clear(), want 0", got)
+	}
+}
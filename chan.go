@@ -0,0 +1,247 @@
+package godash
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The channel helpers below build pipelines out of channels. Each of them runs its own goroutines, which stop once
+// their input channels are closed or their context is done, closing their output channels on the way out, so
+// canceling the context of a pipeline stops all of its stages. The values read from the inputs but not delivered
+// yet when the context is done are dropped.
+
+// ToChan returns a channel that receives the elements of s, in order, and is closed after the last one.
+func ToChan[T any, S ~[]T](ctx context.Context, s S) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, v := range s {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FromChan receives the values of ch until it is closed or the context is done, returning them in order.
+func FromChan[T any](ctx context.Context, ch <-chan T) Slice[T] {
+	result := make(Slice[T], 0)
+	for {
+		v, ok := receive(ctx, ch)
+		if !ok {
+			return result
+		}
+		result = append(result, v)
+	}
+}
+
+// OrDone returns a channel that receives the values of ch until it is closed or the context is done, which saves
+// ranging over ch in a select with ctx.Done().
+func OrDone[T any](ctx context.Context, ch <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := receive(ctx, ch)
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// MapChan returns a channel that receives the result of the mapper for each value of in, in order, like
+// [MapResults]. Errors don't stop the pipeline: they are received as failed results.
+func MapChan[TInput any, TOutput any](ctx context.Context, in <-chan TInput, mapper Mapper[TInput, TOutput]) <-chan Result[TOutput] {
+	out := make(chan Result[TOutput])
+	go func() {
+		defer close(out)
+		for {
+			v, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, NewResult(mapper(v))) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterChan returns a channel that receives the values of in that satisfy the predicate, in order.
+func FilterChan[T any](ctx context.Context, in <-chan T, p Predicate[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			if p(v) && !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FanOut returns n channels sharing the values of in, so they can be processed concurrently by n workers. Each
+// value is received by a single channel, the first one ready for it: the channels are all the same one, so a
+// worker that stops reading doesn't hold any value back. The channels are closed once in is closed.
+// It panics if n is smaller than 1.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic("godash: FanOut called with less than 1 channel")
+	}
+	out := OrDone(ctx, in)
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = out
+	}
+	return outs
+}
+
+// FanIn returns a channel that receives the values of every channel of chans, merging them in the order they
+// arrive. It is closed once all of them are closed.
+func FanIn[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for {
+				v, ok := receive(ctx, ch)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Tee returns n channels that all receive every value of in, in order. A value is delivered to every channel
+// before the next one is read from in, so the channels must be read concurrently, and the slowest reader sets
+// the pace. It panics if n is smaller than 1.
+func Tee[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic("godash: Tee called with less than 1 channel")
+	}
+	chans := make([]chan T, n)
+	outs := make([]<-chan T, n)
+	for i := range chans {
+		chans[i] = make(chan T)
+		outs[i] = chans[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, ch := range chans {
+				close(ch)
+			}
+		}()
+		for {
+			v, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			for _, ch := range chans {
+				if !send(ctx, ch, v) {
+					return
+				}
+			}
+		}
+	}()
+	return outs
+}
+
+// BufferChan returns a channel that receives the values of in in batches, for micro-batching. A batch is sent once
+// it has size values, or once timeout has passed since its first value was received, whichever comes first, and
+// the last batch is sent when in is closed. Batches are never empty. A size smaller than 1 is treated as 1.
+// The clock schedules the timeouts; if nil, the system clock is used.
+func BufferChan[T any](ctx context.Context, in <-chan T, size int, timeout time.Duration, clock Clock) <-chan Slice[T] {
+	size = max(size, 1)
+	clock = clockOrSystem(clock)
+	out := make(chan Slice[T])
+
+	go func() {
+		defer close(out)
+		// tick is signaled by the timeouts without blocking, so they never wait for a batch to be sent, which
+		// would block the clock calling them. Timeouts of batches already sent are ignored by checking the
+		// deadline of the current batch.
+		tick := make(chan struct{}, 1)
+
+		var batch Slice[T]
+		var timer Timer
+		var deadline time.Time
+		flush := func() bool {
+			timer.Stop()
+			full := batch
+			batch = nil
+			return send(ctx, out, full)
+		}
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 {
+					deadline = clock.Now().Add(timeout)
+					timer = clock.AfterFunc(timeout, func() {
+						select {
+						case tick <- struct{}{}:
+						default:
+						}
+					})
+				}
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-tick:
+				if len(batch) > 0 && !clock.Now().Before(deadline) && !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// send sends v to ch, returning false if the context is done first.
+func send[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// receive receives a value from ch, returning false if ch is closed or the context is done first.
+func receive[T any](ctx context.Context, ch <-chan T) (T, bool) {
+	select {
+	case v, ok := <-ch:
+		return v, ok
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
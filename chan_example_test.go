@@ -0,0 +1,45 @@
+package godash_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/taciogt/godash"
+	"slices"
+	"strconv"
+	"time"
+)
+
+func ExampleFanOut() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	words := godash.ToChan(ctx, godash.Slice[string]{"1", "2", "three", "4"})
+	workers := godash.FanOut(ctx, words, 2)
+	results := make([]<-chan godash.Result[int], len(workers))
+	for i, worker := range workers {
+		results[i] = godash.MapChan(ctx, worker, strconv.Atoi)
+	}
+
+	numbers := godash.Slice[int]{}
+	for result := range godash.FanIn(ctx, results...) {
+		if n, err := result.Get(); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+	slices.Sort(numbers)
+	fmt.Println(numbers)
+	// Output:
+	// [1 2 4]
+}
+
+func ExampleBufferChan() {
+	ctx := context.Background()
+
+	for batch := range godash.BufferChan(ctx, godash.ToChan(ctx, godash.Range(1, 8, 1)), 3, time.Second, nil) {
+		fmt.Println(batch)
+	}
+	// Output:
+	// [1 2 3]
+	// [4 5 6]
+	// [7]
+}
@@ -0,0 +1,328 @@
+package godash
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// checkGoroutineLeaks fails the test if it ends with more goroutines than it started with, giving the goroutines
+// stopped by the test some time to exit.
+func checkGoroutineLeaks(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(2 * time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				buf := make([]byte, 1<<16)
+				t.Errorf("%d goroutines leaked:\n%s", runtime.NumGoroutine()-before, buf[:runtime.Stack(buf, true)])
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
+
+func TestToChan_FromChan(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+
+	if got := FromChan(ctx, ToChan(ctx, Slice[int]{1, 2, 3})); !slices.Equal(got, Slice[int]{1, 2, 3}) {
+		t.Errorf("FromChan(ToChan()) = %v, want [1 2 3]", got)
+	}
+	if got := FromChan(ctx, ToChan(ctx, []int{})); got == nil || len(got) != 0 {
+		t.Errorf("FromChan(ToChan()) = %#v, want an empty slice", got)
+	}
+}
+
+func TestChanHelpers_Cancellation(t *testing.T) {
+	// Every stage stops and closes its output once the context is canceled, even if nobody reads it anymore.
+	stages := map[string]func(ctx context.Context, in <-chan int) []<-chan int{
+		"ToChan": func(ctx context.Context, _ <-chan int) []<-chan int {
+			return []<-chan int{ToChan(ctx, []int{1, 2, 3})}
+		},
+		"OrDone": func(ctx context.Context, in <-chan int) []<-chan int {
+			return []<-chan int{OrDone(ctx, in)}
+		},
+		"FilterChan": func(ctx context.Context, in <-chan int) []<-chan int {
+			return []<-chan int{FilterChan(ctx, in, func(int) bool { return true })}
+		},
+		"MapChan": func(ctx context.Context, in <-chan int) []<-chan int {
+			results := MapChan(ctx, in, func(v int) (int, error) { return v, nil })
+			return []<-chan int{OrDone(ctx, FilterChan(ctx, unwrapResults(ctx, results), func(int) bool { return true }))}
+		},
+		"FanOut": func(ctx context.Context, in <-chan int) []<-chan int {
+			return FanOut(ctx, in, 3)
+		},
+		"FanIn": func(ctx context.Context, in <-chan int) []<-chan int {
+			return []<-chan int{FanIn(ctx, in, make(chan int))}
+		},
+		"Tee": func(ctx context.Context, in <-chan int) []<-chan int {
+			return Tee(ctx, in, 2)
+		},
+		"BufferChan": func(ctx context.Context, in <-chan int) []<-chan int {
+			batches := BufferChan(ctx, in, 2, time.Hour, nil)
+			return []<-chan int{OrDone(ctx, FanIn(ctx, unwrapBatches(ctx, batches)))}
+		},
+	}
+
+	for name, stage := range stages {
+		t.Run(name, func(t *testing.T) {
+			checkGoroutineLeaks(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			in := make(chan int, 1)
+			in <- 1 // read by the stage, but never delivered
+			outs := stage(ctx, in)
+			cancel()
+
+			for _, out := range outs {
+				for range out {
+				}
+			}
+		})
+	}
+}
+
+// unwrapResults returns a channel receiving the values of successful results.
+func unwrapResults(ctx context.Context, results <-chan Result[int]) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for r := range OrDone(ctx, results) {
+			if r.IsOk() && !send(ctx, out, r.Unwrap()) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// unwrapBatches returns a channel receiving the values of the batches.
+func unwrapBatches(ctx context.Context, batches <-chan Slice[int]) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for batch := range OrDone(ctx, batches) {
+			for _, v := range batch {
+				if !send(ctx, out, v) {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+func TestMapChan(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+
+	results := FromChan(ctx, MapChan(ctx, ToChan(ctx, []string{"1", "x", "3"}), strconv.Atoi))
+	if len(results) != 3 || results[0].Unwrap() != 1 || !results[1].IsErr() || results[2].Unwrap() != 3 {
+		t.Errorf("MapChan() = %v, want [Ok(1) Err(...) Ok(3)]", results)
+	}
+	var numErr *strconv.NumError
+	if !errors.As(results[1].Err(), &numErr) {
+		t.Errorf("MapChan() error = %v, want a *strconv.NumError", results[1].Err())
+	}
+}
+
+func TestFilterChan(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+
+	even := FilterChan(ctx, ToChan(ctx, []int{1, 2, 3, 4, 5, 6}), func(v int) bool { return v%2 == 0 })
+	if got := FromChan(ctx, even); !slices.Equal(got, Slice[int]{2, 4, 6}) {
+		t.Errorf("FilterChan() = %v, want [2 4 6]", got)
+	}
+}
+
+func TestFanOut_FanIn(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+	values := Range(0, 100, 1)
+
+	workers := FanOut(ctx, ToChan(ctx, values), 4)
+	squared := make([]<-chan int, len(workers))
+	for i, worker := range workers {
+		squared[i] = unwrapResults(ctx, MapChan(ctx, worker, func(v int) (int, error) { return v * v, nil }))
+	}
+
+	got := FromChan(ctx, FanIn(ctx, squared...))
+	slices.Sort(got)
+	want := MustMap(values, func(v int) int { return v * v })
+	if !slices.Equal(got, want) {
+		t.Errorf("FanIn(FanOut()) = %v, want %v", got, want)
+	}
+}
+
+func TestFanOut_IdleWorker(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+
+	// The values go to the channels being read, none is waiting for the idle one.
+	outs := FanOut(ctx, ToChan(ctx, []int{1, 2, 3}), 2)
+	if got := FromChan(ctx, outs[1]); !slices.Equal(got, Slice[int]{1, 2, 3}) {
+		t.Errorf("FanOut() sent %v to the only worker reading, want [1 2 3]", got)
+	}
+}
+
+func TestFanIn_NoChannels(t *testing.T) {
+	checkGoroutineLeaks(t)
+	if got := FromChan(context.Background(), FanIn[int](context.Background())); len(got) != 0 {
+		t.Errorf("FanIn() = %v, want no values", got)
+	}
+}
+
+func TestTee(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+
+	outs := Tee(ctx, ToChan(ctx, []int{1, 2, 3}), 3)
+	got := make([]Slice[int], len(outs))
+	var wg sync.WaitGroup
+	for i, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = FromChan(ctx, out)
+		}()
+	}
+	wg.Wait()
+
+	for i, values := range got {
+		if !slices.Equal(values, Slice[int]{1, 2, 3}) {
+			t.Errorf("Tee() channel %d received %v, want [1 2 3]", i, values)
+		}
+	}
+}
+
+func TestFanOut_Panics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"FanOut": func() { FanOut(context.Background(), make(chan int), 0) },
+		"Tee":    func() { Tee(context.Background(), make(chan int), 0) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s() didn't panic", name)
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestBufferChan(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+	clock := NewFakeClock(time.Unix(0, 0))
+	in := make(chan int)
+	batches := BufferChan(ctx, in, 3, time.Second, clock)
+
+	// A full batch is sent right away.
+	go func() {
+		for _, v := range []int{1, 2, 3, 4} {
+			in <- v
+		}
+	}()
+	if got := <-batches; !slices.Equal(got, Slice[int]{1, 2, 3}) {
+		t.Fatalf("BufferChan() sent %v, want [1 2 3]", got)
+	}
+
+	// The timeout of the first batch doesn't apply to the second one.
+	waitForTimers(t, clock, 1)
+	clock.Advance(500 * time.Millisecond)
+	in <- 5
+	select {
+	case got := <-batches:
+		t.Fatalf("BufferChan() sent %v before the timeout", got)
+	default:
+	}
+
+	// A partial batch is sent once its timeout passes.
+	clock.Advance(500 * time.Millisecond)
+	if got := <-batches; !slices.Equal(got, Slice[int]{4, 5}) {
+		t.Fatalf("BufferChan() sent %v, want [4 5]", got)
+	}
+
+	// The last batch is sent when the input is closed.
+	in <- 6
+	close(in)
+	if got := <-batches; !slices.Equal(got, Slice[int]{6}) {
+		t.Fatalf("BufferChan() sent %v, want [6]", got)
+	}
+	if _, ok := <-batches; ok {
+		t.Error("BufferChan() didn't close its output")
+	}
+	if clock.PendingTimers() != 0 {
+		t.Errorf("BufferChan() left %d timers", clock.PendingTimers())
+	}
+}
+
+func TestBufferChan_TimeoutWhileSending(t *testing.T) {
+	checkGoroutineLeaks(t)
+	ctx := context.Background()
+	clock := &lateClock{FakeClock: NewFakeClock(time.Unix(0, 0)), timeouts: make(chan func(), 1)}
+	in := make(chan int)
+	batches := BufferChan(ctx, in, 2, time.Second, clock)
+
+	// The timeout of a batch may be called while the batch is waiting to be sent, if it was already
+	// running when the batch was filled up.
+	in <- 1
+	timeout := <-clock.timeouts
+	in <- 2
+	called := make(chan struct{})
+	go func() {
+		clock.Advance(time.Second)
+		timeout()
+		close(called)
+	}()
+	select {
+	case <-called:
+	case <-time.After(2 * time.Second):
+		t.Fatal("the timeout of BufferChan() blocked until the batch was received")
+	}
+
+	if got := <-batches; !slices.Equal(got, Slice[int]{1, 2}) {
+		t.Fatalf("BufferChan() sent %v, want [1 2]", got)
+	}
+	close(in)
+	if _, ok := <-batches; ok {
+		t.Error("BufferChan() didn't close its output")
+	}
+}
+
+// lateClock is a FakeClock whose scheduled functions are handed to the test instead, and can't be stopped,
+// as if they were already running.
+type lateClock struct {
+	*FakeClock
+	timeouts chan func()
+}
+
+func (c *lateClock) AfterFunc(_ time.Duration, f func()) Timer {
+	c.timeouts <- f
+	return lateTimer{}
+}
+
+type lateTimer struct{}
+
+func (lateTimer) Stop() bool { return false }
+
+// waitForTimers waits until the clock has n pending timers, which are scheduled by other goroutines.
+func waitForTimers(t *testing.T, clock *FakeClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for clock.PendingTimers() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("the clock has %d pending timers, want %d", clock.PendingTimers(), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
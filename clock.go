@@ -0,0 +1,139 @@
+package godash
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and schedules functions to run later. Functions that depend on time take a Clock
+// so they can be driven by a [FakeClock] in tests, instead of waiting for the real time to pass.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// AfterFunc calls f once the duration d has elapsed. The system clock calls it in its own goroutine,
+	// like [time.AfterFunc].
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a function call scheduled by [Clock.AfterFunc].
+type Timer interface {
+	// Stop prevents the call from happening. It returns false if the call already happened or was stopped.
+	Stop() bool
+}
+
+// systemClock is a Clock backed by the time package.
+type systemClock struct{}
+
+// Now returns the current local time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// AfterFunc calls f after d using time.AfterFunc.
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// SystemClock returns a Clock that tells the real time, as returned by time.Now, and schedules calls
+// with time.AfterFunc.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// clockOrSystem returns c, or the system clock if c is nil.
+func clockOrSystem(c Clock) Clock {
+	if c == nil {
+		return SystemClock()
+	}
+	return c
+}
+
+// FakeClock is a Clock whose time only changes when told to, so tests can control it deterministically.
+// Functions scheduled with [FakeClock.AfterFunc] run synchronously during the [FakeClock.Advance] call that
+// reaches their time. It is safe for concurrent use.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer // sorted by deadline, then by creation
+}
+
+// fakeTimer is a call scheduled on a FakeClock.
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	f        func()
+}
+
+// NewFakeClock creates a FakeClock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc schedules f to be called by the [FakeClock.Advance] call that moves the clock at least d forward.
+// If d is zero or negative, f is called by the next Advance call.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	timer := &fakeTimer{clock: c, deadline: c.now.Add(d), f: f}
+	i, _ := slices.BinarySearchFunc(c.timers, timer.deadline, func(t *fakeTimer, deadline time.Time) int {
+		if t.deadline.After(deadline) {
+			return 1
+		}
+		return -1 // timers with the same deadline run in the order they were created
+	})
+	c.timers = slices.Insert(c.timers, i, timer)
+	return timer
+}
+
+// Advance moves the time of the clock forward by d, calling the scheduled functions whose time is reached
+// in order. While each function runs, the clock tells the time it was scheduled for, and functions it
+// schedules are also called if their time is reached.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	for len(c.timers) > 0 && !c.timers[0].deadline.After(target) {
+		timer := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = maxTime(c.now, timer.deadline)
+		c.mu.Unlock()
+		timer.f()
+		c.mu.Lock()
+	}
+	c.now = maxTime(c.now, target)
+	c.mu.Unlock()
+}
+
+// PendingTimers returns the number of scheduled functions that weren't called or stopped yet.
+func (c *FakeClock) PendingTimers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// Stop removes the call from its clock. It returns false if the call already happened or was stopped.
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	i := slices.Index(t.clock.timers, t)
+	if i < 0 {
+		return false
+	}
+	t.clock.timers = slices.Delete(t.clock.timers, i, i+1)
+	return true
+}
+
+// maxTime returns the latest of two times.
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
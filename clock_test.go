@@ -0,0 +1,84 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSystemClock(t *testing.T) {
+	before := time.Now()
+	got := SystemClock().Now()
+	if got.Before(before) || got.After(time.Now()) {
+		t.Errorf("Now() = %v, want the current time", got)
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v, want %v", got, start)
+	}
+
+	clock.Advance(90 * time.Second)
+	if got, want := clock.Now(), start.Add(90*time.Second); !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+}
+
+func TestSystemClock_AfterFunc(t *testing.T) {
+	done := make(chan struct{})
+	SystemClock().AfterFunc(time.Millisecond, func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("AfterFunc() didn't call the function")
+	}
+
+	if SystemClock().AfterFunc(time.Hour, func() {}).Stop() != true {
+		t.Error("Stop() = false for a pending timer, want true")
+	}
+}
+
+func TestFakeClock_AfterFunc(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := NewFakeClock(start)
+	calls := make([]string, 0)
+	record := func(name string) func() {
+		return func() {
+			calls = append(calls, fmt.Sprintf("%s@%v", name, clock.Now().Sub(start)))
+		}
+	}
+
+	clock.AfterFunc(3*time.Second, record("c"))
+	clock.AfterFunc(time.Second, record("a"))
+	clock.AfterFunc(time.Second, record("b"))
+	stopped := clock.AfterFunc(2*time.Second, record("stopped"))
+	clock.AfterFunc(2*time.Second, func() {
+		record("nested")()
+		clock.AfterFunc(500*time.Millisecond, record("scheduled by nested"))
+	})
+
+	if !stopped.Stop() || stopped.Stop() {
+		t.Error("Stop() should return true only the first time")
+	}
+	if got := clock.PendingTimers(); got != 4 {
+		t.Errorf("PendingTimers() = %d, want 4", got)
+	}
+
+	clock.Advance(2500 * time.Millisecond)
+	want := []string{"a@1s", "b@1s", "nested@2s", "scheduled by nested@2.5s"}
+	if fmt.Sprint(calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+	if got := clock.Now().Sub(start); got != 2500*time.Millisecond {
+		t.Errorf("Now() is %v after the start, want 2.5s", got)
+	}
+
+	clock.Advance(time.Hour)
+	if len(calls) != 5 || clock.PendingTimers() != 0 {
+		t.Errorf("calls = %v with %d pending timers, want c to be called", calls, clock.PendingTimers())
+	}
+}
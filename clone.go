@@ -0,0 +1,131 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Cloner is implemented by types that know how to make a deep copy of themselves, such as types holding
+// resources that must not be shared or copied field by field. [DeepClone] calls DeepClone instead of copying
+// values of those types, and the result must have the same type as the value.
+type Cloner interface {
+	DeepClone() any
+}
+
+// DeepClone returns a deep copy of v: the values pointed to and held by maps, slices, arrays, structs and
+// interfaces are copied recursively, so nothing reachable from the copy is shared with v. Pointers and maps that
+// are shared within v, including cycles, are copied once, so they are shared the same way within the copy. So are
+// slices with identical headers, starting at the same element with the same length, while slices that only
+// overlap, like s[:2] and s[1:], are copied separately and don't share their elements within the copy.
+//
+// Values implementing [Cloner] are copied by their DeepClone method. Unexported struct fields can't be set
+// through reflection, so they are copied shallowly. Functions and channels are not copied.
+func DeepClone[T any](v T) T {
+	var cloned T
+	reflect.ValueOf(&cloned).Elem().Set(deepCloneValue(reflect.ValueOf(&v).Elem()))
+	return cloned
+}
+
+// deepCloneValue behaves like DeepClone for a reflect.Value, returning a deep copy with the same type.
+func deepCloneValue(v reflect.Value) reflect.Value {
+	return cloner{visited: make(map[visitKey]reflect.Value)}.clone(v)
+}
+
+// visitKey identifies a pointer, map or slice already copied by a cloner. The length is needed because slices
+// of the same array with different lengths are different values, which are copied separately.
+type visitKey struct {
+	pointer uintptr
+	length  int
+	t       reflect.Type
+}
+
+// cloner makes deep copies, keeping track of the values already copied.
+type cloner struct {
+	visited map[visitKey]reflect.Value
+}
+
+var clonerType = reflect.TypeFor[Cloner]()
+
+// clone returns a deep copy of v, of the same type.
+func (c cloner) clone(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	if isNillable(v.Kind()) && v.IsNil() {
+		return v
+	}
+	if v.Type().Implements(clonerType) && v.CanInterface() {
+		return c.cloneWithMethod(v)
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		return c.visit(v, 0, func() reflect.Value {
+			return reflect.New(v.Type().Elem())
+		}, func(cloned reflect.Value) {
+			cloned.Elem().Set(c.clone(v.Elem()))
+		})
+	case reflect.Map:
+		return c.visit(v, 0, func() reflect.Value {
+			return reflect.MakeMapWithSize(v.Type(), v.Len())
+		}, func(cloned reflect.Value) {
+			for iter := v.MapRange(); iter.Next(); {
+				cloned.SetMapIndex(c.clone(iter.Key()), c.clone(iter.Value()))
+			}
+		})
+	case reflect.Slice:
+		return c.visit(v, v.Len(), func() reflect.Value {
+			return reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		}, func(cloned reflect.Value) {
+			for i := 0; i < v.Len(); i++ {
+				cloned.Index(i).Set(c.clone(v.Index(i)))
+			}
+		})
+	case reflect.Array:
+		cloned := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			cloned.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return cloned
+	case reflect.Struct:
+		cloned := reflect.New(v.Type()).Elem()
+		cloned.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if field := cloned.Field(i); field.CanSet() {
+				field.Set(c.clone(v.Field(i)))
+			}
+		}
+		return cloned
+	case reflect.Interface:
+		cloned := reflect.New(v.Type()).Elem()
+		cloned.Set(c.clone(v.Elem()))
+		return cloned
+	default:
+		return v
+	}
+}
+
+// visit returns the copy of a pointer, map or slice, reusing the copy made before if v was already visited.
+// Otherwise, the copy is created by create and registered before being filled by fill, so cycles reach it.
+func (c cloner) visit(v reflect.Value, length int, create func() reflect.Value, fill func(reflect.Value)) reflect.Value {
+	key := visitKey{pointer: v.Pointer(), length: length, t: v.Type()}
+	if cloned, ok := c.visited[key]; ok {
+		return cloned
+	}
+	cloned := create()
+	c.visited[key] = cloned
+	fill(cloned)
+	return cloned
+}
+
+// cloneWithMethod returns the copy of a value implementing Cloner made by its DeepClone method.
+func (c cloner) cloneWithMethod(v reflect.Value) reflect.Value {
+	result := v.Interface().(Cloner).DeepClone()
+	cloned := reflect.ValueOf(result)
+	if !cloned.IsValid() || !cloned.Type().AssignableTo(v.Type()) {
+		panic(fmt.Sprintf("godash: the DeepClone method of %v returned %#v", v.Type(), result))
+	}
+	assigned := reflect.New(v.Type()).Elem()
+	assigned.Set(cloned)
+	return assigned
+}
@@ -0,0 +1,35 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleDeepClone() {
+	original := map[string][]int{"primes": {2, 3, 5}}
+	cloned := godash.DeepClone(original)
+	cloned["primes"][0] = 7
+
+	fmt.Println(original, cloned)
+	// Output:
+	// map[primes:[2 3 5]] map[primes:[7 3 5]]
+}
+
+func ExampleDeepMerge() {
+	type Server struct {
+		Host   string
+		Port   int
+		Tags   []string
+		Limits map[string]int
+	}
+	defaults := Server{Host: "localhost", Port: 8080, Tags: []string{"web"}, Limits: map[string]int{"conns": 100, "rps": 10}}
+	overrides := Server{Port: 9090, Tags: []string{"web", "api"}, Limits: map[string]int{"rps": 50}}
+
+	_ = godash.DeepMerge(&defaults, overrides, godash.MergeOptions{
+		Slices: godash.MergeSlicesUnion,
+		Nil:    godash.MergeZeroSkip,
+	})
+	fmt.Printf("%+v\n", defaults)
+	// Output:
+	// {Host:localhost Port:9090 Tags:[web api] Limits:map[conns:100 rps:50]}
+}
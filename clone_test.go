@@ -0,0 +1,93 @@
+package godash
+
+import (
+	"reflect"
+	"testing"
+)
+
+type cloneNode struct {
+	Value    int
+	Children []*cloneNode
+	Parent   *cloneNode
+	Labels   map[string][]string
+	Extra    any
+	hidden   *int
+}
+
+// cloneCounter implements Cloner, counting how many times it was copied.
+type cloneCounter struct {
+	copies *int
+}
+
+func (c cloneCounter) DeepClone() any {
+	*c.copies++
+	return cloneCounter{copies: c.copies}
+}
+
+func TestDeepClone(t *testing.T) {
+	t.Run("nested values", func(t *testing.T) {
+		hidden := 7
+		original := &cloneNode{
+			Value:  1,
+			Labels: map[string][]string{"a": {"x", "y"}},
+			Extra:  []any{map[string]any{"k": []int{1}}},
+			hidden: &hidden,
+		}
+		original.Children = []*cloneNode{{Value: 2, Parent: original}}
+
+		cloned := DeepClone(original)
+		if !reflect.DeepEqual(cloned, original) {
+			t.Fatalf("DeepClone() = %+v, want a value equal to %+v", cloned, original)
+		}
+
+		cloned.Labels["a"][0] = "changed"
+		cloned.Extra.([]any)[0].(map[string]any)["k"].([]int)[0] = 99
+		cloned.Children[0].Value = 20
+		if original.Labels["a"][0] != "x" || original.Extra.([]any)[0].(map[string]any)["k"].([]int)[0] != 1 ||
+			original.Children[0].Value != 2 {
+			t.Error("changing the clone changed the original")
+		}
+
+		if cloned.Children[0].Parent != cloned {
+			t.Error("the cycle of the clone doesn't lead back to the clone")
+		}
+		if cloned.hidden != original.hidden {
+			t.Error("unexported fields should be copied shallowly")
+		}
+	})
+
+	t.Run("shared values stay shared", func(t *testing.T) {
+		shared := []int{1, 2, 3}
+		original := map[string][]int{"a": shared, "b": shared}
+		cloned := DeepClone(original)
+		cloned["a"][0] = 10
+		if cloned["b"][0] != 10 || shared[0] != 1 {
+			t.Errorf("cloned = %v, original = %v, want the slices of the clone to be shared", cloned, original)
+		}
+	})
+
+	t.Run("Cloner", func(t *testing.T) {
+		copies := 0
+		original := []any{cloneCounter{copies: &copies}, map[int]cloneCounter{1: {copies: &copies}}}
+		DeepClone(original)
+		if copies != 2 {
+			t.Errorf("DeepClone was called %d times, want 2", copies)
+		}
+	})
+
+	t.Run("nil and scalar values", func(t *testing.T) {
+		var nilMap map[string]int
+		if got := DeepClone(nilMap); got != nil {
+			t.Errorf("DeepClone(nil map) = %v, want nil", got)
+		}
+		if got := DeepClone[any](nil); got != nil {
+			t.Errorf("DeepClone(nil) = %v, want nil", got)
+		}
+		if got := DeepClone("text"); got != "text" {
+			t.Errorf("DeepClone(text) = %q, want \"text\"", got)
+		}
+		if got := DeepClone([2][]int{{1}, {2}}); !reflect.DeepEqual(got, [2][]int{{1}, {2}}) {
+			t.Errorf("DeepClone(array) = %v, want [[1] [2]]", got)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+package godash
+
+// Permutations returns every ordering of the elements of s. See [PermutationsSeq] for the ordering of the results.
+// The number of permutations grows factorially, so prefer [PermutationsSeq] for anything but small inputs.
+func Permutations[T any, S ~[]T](s S) []Slice[T] {
+	return Collect(PermutationsSeq(s))
+}
+
+// PermutationsSeq returns a Seq over every ordering of the elements of s, without materialising them all at once.
+// The permutations are yielded in lexicographic order of the indexes of the elements in s, so for
+// s = [a b c] they are [a b c], [a c b], [b a c], [b c a], [c a b], [c b a]. Elements are treated as distinct
+// based on their position, even if they are equal. An empty s yields a single empty permutation.
+//
+// Every yielded Slice is a new one, so it can be kept by the caller.
+func PermutationsSeq[T any, S ~[]T](s S) Seq[Slice[T]] {
+	return func(yield func(Slice[T]) bool) {
+		indexes := Range(0, len(s), 1)
+		for {
+			if !yield(pick(s, indexes)) {
+				return
+			}
+			if !nextPermutation(indexes) {
+				return
+			}
+		}
+	}
+}
+
+// nextPermutation rearranges indexes into the next permutation in lexicographic order.
+// It returns false if indexes is already the last permutation.
+func nextPermutation(indexes []int) bool {
+	i := len(indexes) - 2
+	for i >= 0 && indexes[i] >= indexes[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+
+	j := len(indexes) - 1
+	for indexes[j] <= indexes[i] {
+		j--
+	}
+	indexes[i], indexes[j] = indexes[j], indexes[i]
+	Reverse(indexes[i+1:])
+	return true
+}
+
+// Combinations returns every selection of k elements of s, disregarding their order.
+// See [CombinationsSeq] for the ordering of the results.
+func Combinations[T any, S ~[]T](s S, k int) []Slice[T] {
+	return Collect(CombinationsSeq(s, k))
+}
+
+// CombinationsSeq returns a Seq over every selection of k elements of s, disregarding their order.
+// Each combination keeps the elements in the order they appear in s, and the combinations are yielded in
+// lexicographic order of their indexes, so for s = [a b c] and k = 2 they are [a b], [a c], [b c].
+// If k is zero, a single empty combination is yielded. If k is negative or greater than the length of s,
+// nothing is yielded.
+//
+// Every yielded Slice is a new one, so it can be kept by the caller.
+func CombinationsSeq[T any, S ~[]T](s S, k int) Seq[Slice[T]] {
+	return func(yield func(Slice[T]) bool) {
+		n := len(s)
+		if k < 0 || k > n {
+			return
+		}
+
+		indexes := Range(0, k, 1)
+		for {
+			if !yield(pick(s, indexes)) {
+				return
+			}
+
+			i := k - 1
+			for i >= 0 && indexes[i] == i+n-k {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacement returns every selection of k elements of s, disregarding their order and
+// allowing each element to be selected more than once. See [CombinationsWithReplacementSeq] for the ordering
+// of the results.
+func CombinationsWithReplacement[T any, S ~[]T](s S, k int) []Slice[T] {
+	return Collect(CombinationsWithReplacementSeq(s, k))
+}
+
+// CombinationsWithReplacementSeq returns a Seq over every selection of k elements of s, disregarding their
+// order and allowing each element to be selected more than once. The combinations are yielded in lexicographic
+// order of their indexes, so for s = [a b] and k = 2 they are [a a], [a b], [b b].
+// If k is zero, a single empty combination is yielded. If k is negative, or s is empty and k is positive,
+// nothing is yielded.
+//
+// Every yielded Slice is a new one, so it can be kept by the caller.
+func CombinationsWithReplacementSeq[T any, S ~[]T](s S, k int) Seq[Slice[T]] {
+	return func(yield func(Slice[T]) bool) {
+		n := len(s)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+
+		indexes := make([]int, k)
+		for {
+			if !yield(pick(s, indexes)) {
+				return
+			}
+
+			i := k - 1
+			for i >= 0 && indexes[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[i]
+			}
+		}
+	}
+}
+
+// PowerSet returns every subset of set, including the empty set and set itself.
+// See [PowerSetSeq] for the ordering of the results.
+// The number of subsets doubles with each element, so prefer [PowerSetSeq] for anything but small sets.
+func PowerSet[T setElement](set Set[T]) []Set[T] {
+	return Collect(PowerSetSeq(set))
+}
+
+// PowerSetSeq returns a Seq over every subset of set, including the empty set and set itself.
+//
+// Since sets are unordered, the elements are first sorted by their string representation (the same order
+// used by [Set.String]) so the output is reproducible. The subsets are yielded by increasing size, and
+// subsets of the same size follow the order of [CombinationsSeq] over the sorted elements.
+func PowerSetSeq[T setElement](set Set[T]) Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		elements := sortedElements(set)
+		for k := 0; k <= len(elements); k++ {
+			keepGoing := true
+			CombinationsSeq(elements, k)(func(c Slice[T]) bool {
+				keepGoing = yield(NewSet(c...))
+				return keepGoing
+			})
+			if !keepGoing {
+				return
+			}
+		}
+	}
+}
+
+// CartesianProduct returns every tuple made of one element of each of the given slices.
+// See [CartesianProductSeq] for the ordering of the results.
+func CartesianProduct[T any, S ~[]T](slices ...S) []Slice[T] {
+	return Collect(CartesianProductSeq(slices...))
+}
+
+// CartesianProductSeq returns a Seq over every tuple made of one element of each of the given slices.
+// The tuples are yielded in lexicographic order of their indexes, with the last slice varying fastest, so for
+// [a b] and [1 2] they are [a 1], [a 2], [b 1], [b 2]. If any slice is empty, nothing is yielded. If no slices
+// are given, a single empty tuple is yielded.
+//
+// Every yielded Slice is a new one, so it can be kept by the caller.
+func CartesianProductSeq[T any, S ~[]T](slices ...S) Seq[Slice[T]] {
+	return func(yield func(Slice[T]) bool) {
+		for _, s := range slices {
+			if len(s) == 0 {
+				return
+			}
+		}
+
+		indexes := make([]int, len(slices))
+		for {
+			tuple := make(Slice[T], len(slices))
+			for i, s := range slices {
+				tuple[i] = s[indexes[i]]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			i := len(slices) - 1
+			for i >= 0 && indexes[i] == len(slices[i])-1 {
+				indexes[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+		}
+	}
+}
+
+// pick returns a new Slice with the elements of s at the given indexes.
+func pick[T any, S ~[]T](s S, indexes []int) Slice[T] {
+	result := make(Slice[T], len(indexes))
+	for i, index := range indexes {
+		result[i] = s[index]
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExamplePermutations() {
+	for _, p := range godash.Permutations([]int{1, 2, 3}) {
+		fmt.Println(p)
+	}
+	// Output:
+	// [1 2 3]
+	// [1 3 2]
+	// [2 1 3]
+	// [2 3 1]
+	// [3 1 2]
+	// [3 2 1]
+}
+
+func ExampleCombinationsSeq() {
+	combinations := godash.CombinationsSeq([]string{"a", "b", "c", "d"}, 2)
+	combinations(func(c godash.Slice[string]) bool {
+		fmt.Println(c)
+		return c[0] != "b"
+	})
+	// Output:
+	// [a b]
+	// [a c]
+	// [a d]
+	// [b c]
+}
+
+func ExamplePowerSet() {
+	fmt.Println(godash.PowerSet(godash.NewSet(2, 1)))
+	// Output:
+	// [set{} set{1} set{2} set{1, 2}]
+}
+
+func ExampleCartesianProduct() {
+	sizes := []string{"S", "M"}
+	colors := []string{"red", "blue"}
+	fmt.Println(godash.CartesianProduct(sizes, colors))
+	// Output:
+	// [[S red] [S blue] [M red] [M blue]]
+}
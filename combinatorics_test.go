@@ -0,0 +1,240 @@
+package godash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []Slice[string]
+	}{{
+		name:  "three elements",
+		input: []string{"a", "b", "c"},
+		want: []Slice[string]{
+			{"a", "b", "c"}, {"a", "c", "b"}, {"b", "a", "c"}, {"b", "c", "a"}, {"c", "a", "b"}, {"c", "b", "a"},
+		},
+	}, {
+		name:  "equal elements are treated as distinct",
+		input: []string{"a", "a"},
+		want:  []Slice[string]{{"a", "a"}, {"a", "a"}},
+	}, {
+		name:  "single element",
+		input: []string{"a"},
+		want:  []Slice[string]{{"a"}},
+	}, {
+		name:  "empty slice",
+		input: []string{},
+		want:  []Slice[string]{{}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Permutations(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Permutations(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("number of permutations", func(t *testing.T) {
+		if got := len(Permutations(Range(0, 6, 1))); got != 720 {
+			t.Errorf("len(Permutations()) = %d, want 720", got)
+		}
+	})
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  []Slice[int]
+	}{{
+		name:  "pairs",
+		input: []int{1, 2, 3, 4},
+		k:     2,
+		want:  []Slice[int]{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}},
+	}, {
+		name:  "all elements",
+		input: []int{1, 2, 3},
+		k:     3,
+		want:  []Slice[int]{{1, 2, 3}},
+	}, {
+		name:  "zero elements",
+		input: []int{1, 2, 3},
+		k:     0,
+		want:  []Slice[int]{{}},
+	}, {
+		name:  "k greater than the length",
+		input: []int{1, 2},
+		k:     3,
+		want:  []Slice[int]{},
+	}, {
+		name:  "negative k",
+		input: []int{1, 2},
+		k:     -1,
+		want:  []Slice[int]{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Combinations(tt.input, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Combinations(%v, %d) = %v, want %v", tt.input, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  []Slice[int]
+	}{{
+		name:  "pairs",
+		input: []int{1, 2, 3},
+		k:     2,
+		want:  []Slice[int]{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}},
+	}, {
+		name:  "k greater than the length",
+		input: []int{1},
+		k:     3,
+		want:  []Slice[int]{{1, 1, 1}},
+	}, {
+		name:  "zero elements",
+		input: []int{1, 2},
+		k:     0,
+		want:  []Slice[int]{{}},
+	}, {
+		name:  "empty slice",
+		input: []int{},
+		k:     2,
+		want:  []Slice[int]{},
+	}, {
+		name:  "negative k",
+		input: []int{1, 2},
+		k:     -1,
+		want:  []Slice[int]{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CombinationsWithReplacement(tt.input, tt.k); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CombinationsWithReplacement(%v, %d) = %v, want %v", tt.input, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Set[string]
+		want  []Set[string]
+	}{{
+		name:  "three elements",
+		input: NewSet("c", "a", "b"),
+		want: []Set[string]{
+			NewSet[string](),
+			NewSet("a"), NewSet("b"), NewSet("c"),
+			NewSet("a", "b"), NewSet("a", "c"), NewSet("b", "c"),
+			NewSet("a", "b", "c"),
+		},
+	}, {
+		name:  "empty set",
+		input: NewSet[string](),
+		want:  []Set[string]{NewSet[string]()},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PowerSet(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PowerSet(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("output is reproducible", func(t *testing.T) {
+		first := PowerSet(NewSet(Range(0, 8, 1)...))
+		for i := 0; i < 10; i++ {
+			if got := PowerSet(NewSet(Range(0, 8, 1)...)); !reflect.DeepEqual(got, first) {
+				t.Fatalf("PowerSet() = %v, want %v", got, first)
+			}
+		}
+	})
+}
+
+func TestCartesianProduct(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices [][]string
+		want   []Slice[string]
+	}{{
+		name:   "two slices",
+		slices: [][]string{{"a", "b"}, {"1", "2", "3"}},
+		want:   []Slice[string]{{"a", "1"}, {"a", "2"}, {"a", "3"}, {"b", "1"}, {"b", "2"}, {"b", "3"}},
+	}, {
+		name:   "three slices",
+		slices: [][]string{{"a"}, {"1", "2"}, {"x", "y"}},
+		want:   []Slice[string]{{"a", "1", "x"}, {"a", "1", "y"}, {"a", "2", "x"}, {"a", "2", "y"}},
+	}, {
+		name:   "single slice",
+		slices: [][]string{{"a", "b"}},
+		want:   []Slice[string]{{"a"}, {"b"}},
+	}, {
+		name:   "an empty slice",
+		slices: [][]string{{"a", "b"}, {}},
+		want:   []Slice[string]{},
+	}, {
+		name:   "no slices",
+		slices: [][]string{},
+		want:   []Slice[string]{{}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CartesianProduct(tt.slices...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CartesianProduct(%v) = %v, want %v", tt.slices, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinatoricsSeqStopsEarly(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  Seq[Slice[int]]
+	}{
+		{name: "PermutationsSeq", seq: PermutationsSeq(Range(0, 20, 1))},
+		{name: "CombinationsSeq", seq: CombinationsSeq(Range(0, 40, 1), 20)},
+		{name: "CombinationsWithReplacementSeq", seq: CombinationsWithReplacementSeq(Range(0, 40, 1), 20)},
+		{name: "CartesianProductSeq", seq: CartesianProductSeq(Repeat(Range(0, 10, 1), 20)...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := 0
+			tt.seq(func(Slice[int]) bool {
+				count++
+				return count < 3
+			})
+			if count != 3 {
+				t.Errorf("yield was called %d times, want 3", count)
+			}
+		})
+	}
+
+	t.Run("PowerSetSeq", func(t *testing.T) {
+		count := 0
+		PowerSetSeq(NewSet(Range(0, 40, 1)...))(func(Set[int]) bool {
+			count++
+			return count < 3
+		})
+		if count != 3 {
+			t.Errorf("yield was called %d times, want 3", count)
+		}
+	})
+}
@@ -33,3 +33,28 @@ func IndexOf[T comparable](s ComparableSlice[T], value T) (int, bool) {
 func (s ComparableSlice[T]) IndexOf(value T) (int, bool) {
 	return IndexOf(s, value)
 }
+
+// Intersection behaves like [IntersectSlices] function, except it is called directly on the slice.
+func (s ComparableSlice[T]) Intersection(other ComparableSlice[T]) ComparableSlice[T] {
+	return NewComparableSlice(IntersectSlices(s.Slice, other.Slice)...)
+}
+
+// Union behaves like [UnionSlices] function, except it is called directly on the slice.
+func (s ComparableSlice[T]) Union(other ComparableSlice[T]) ComparableSlice[T] {
+	return NewComparableSlice(UnionSlices(s.Slice, other.Slice)...)
+}
+
+// Difference behaves like [DifferenceSlices] function, except it is called directly on the slice.
+func (s ComparableSlice[T]) Difference(other ComparableSlice[T]) ComparableSlice[T] {
+	return NewComparableSlice(DifferenceSlices(s.Slice, other.Slice)...)
+}
+
+// Xor behaves like [XorSlices] function, except it is called directly on the slice.
+func (s ComparableSlice[T]) Xor(other ComparableSlice[T]) ComparableSlice[T] {
+	return NewComparableSlice(XorSlices(s.Slice, other.Slice)...)
+}
+
+// Without behaves like [Without] function, except it is called directly on the slice.
+func (s ComparableSlice[T]) Without(values ...T) ComparableSlice[T] {
+	return NewComparableSlice(Without(s.Slice, values...)...)
+}
@@ -14,3 +14,33 @@ func ExampleComparableSlice_Includes() {
 	// true
 	// false
 }
+
+func ExampleComparableSlice_Union() {
+	s := godash.NewComparableSlice("b", "a", "c")
+	other := godash.NewComparableSlice("d", "a", "e")
+
+	fmt.Println(s.Union(other).ToRaw())
+	fmt.Println(s.Intersection(other).ToRaw())
+	fmt.Println(s.Difference(other).ToRaw())
+	fmt.Println(s.Xor(other).ToRaw())
+
+	// Output:
+	// [b a c d e]
+	// [a]
+	// [b c]
+	// [b c d e]
+}
+
+func ExampleDifferenceSlicesBy() {
+	type user struct {
+		id   int
+		name string
+	}
+	all := []user{{1, "ana"}, {2, "bob"}, {3, "carl"}}
+	blocked := []user{{2, "bob"}}
+	byID := func(u user) int { return u.id }
+
+	fmt.Println(godash.DifferenceSlicesBy(all, blocked, byID))
+	// Output:
+	// [{1 ana} {3 carl}]
+}
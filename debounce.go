@@ -0,0 +1,192 @@
+package godash
+
+import (
+	"sync"
+	"time"
+)
+
+// DebounceOptions configures [Debounce]. The zero value calls the function on the trailing edge only,
+// with no maximum wait, using the system clock.
+type DebounceOptions struct {
+	// Leading calls the function on the leading edge, i.e. right away when a burst of calls starts.
+	Leading bool
+	// Trailing calls the function on the trailing edge, i.e. once no calls happened for the wait duration.
+	// If neither Leading nor Trailing is set, Trailing is assumed.
+	Trailing bool
+	// MaxWait is the longest the function can be delayed while calls keep coming, after which it is called
+	// anyway. Zero or a negative value means there is no maximum, and a value shorter than the wait is raised to it.
+	MaxWait time.Duration
+	// Clock tells the time and schedules the delayed calls. If nil, the system clock is used.
+	Clock Clock
+}
+
+// Debounced wraps a function so it is called at most once per burst of calls. It is created by [Debounce]
+// or [Throttle], and is safe for concurrent use.
+type Debounced[T any] struct {
+	fn      func(T)
+	wait    time.Duration
+	options DebounceOptions
+	clock   Clock
+
+	mu         sync.Mutex
+	timer      Timer
+	generation int // identifies the current timer, so a stopped timer that already fired is ignored
+	arg        T
+	hasArg     bool // whether arg wasn't passed to fn yet
+	called     bool
+	lastCall   time.Time
+	lastInvoke time.Time
+}
+
+// Debounce wraps fn so calling [Debounced.Call] delays calling fn until wait has elapsed since the last call,
+// following lodash's debounce. When fn is finally called, it receives the argument of the latest call.
+//
+// With the Leading option, fn is called right away by the first call of a burst, and the following calls of the
+// burst are handled as usual. With both Leading and Trailing, fn is called on the trailing edge only if there
+// was more than one call in the burst. With MaxWait, fn is called at least once every MaxWait while calls keep coming.
+func Debounce[T any](fn func(T), wait time.Duration, options DebounceOptions) *Debounced[T] {
+	if !options.Leading && !options.Trailing {
+		options.Trailing = true
+	}
+	if options.MaxWait > 0 {
+		options.MaxWait = max(options.MaxWait, wait)
+	}
+	return &Debounced[T]{fn: fn, wait: wait, options: options, clock: clockOrSystem(options.Clock)}
+}
+
+// Throttle wraps fn so it is called at most once every interval, with the argument of the latest call.
+// The first call of a burst calls fn right away, and the latest call during the interval is delayed until
+// its end. It behaves like [Debounce] with the Leading and Trailing options and a MaxWait of interval.
+// If clock is nil, the system clock is used.
+func Throttle[T any](fn func(T), interval time.Duration, clock Clock) *Debounced[T] {
+	return Debounce(fn, interval, DebounceOptions{Leading: true, Trailing: true, MaxWait: interval, Clock: clock})
+}
+
+// Call registers a call with the argument v, calling the wrapped function now or later depending on the options.
+// The wrapped function runs in the calling goroutine when it is called right away.
+func (d *Debounced[T]) Call(v T) {
+	d.mu.Lock()
+	now := d.clock.Now()
+	invoking := d.shouldInvoke(now)
+	d.arg, d.hasArg = v, true
+	d.called, d.lastCall = true, now
+
+	var invoke bool
+	switch {
+	case invoking && d.timer == nil:
+		// leading edge
+		d.lastInvoke = now
+		d.schedule(d.wait)
+		invoke = d.options.Leading
+	case invoking && d.options.MaxWait > 0:
+		// the maximum wait was reached during a burst
+		d.timer.Stop()
+		d.schedule(d.wait)
+		invoke = true
+	case d.timer == nil:
+		d.schedule(d.wait)
+	}
+
+	if invoke {
+		d.invoke(now)
+		return
+	}
+	d.mu.Unlock()
+}
+
+// Cancel discards the pending trailing call, if any, and resets the wrapper so the next call starts a new burst.
+func (d *Debounced[T]) Cancel() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	var zero T
+	d.generation++
+	d.timer, d.arg, d.hasArg = nil, zero, false
+	d.called, d.lastCall, d.lastInvoke = false, time.Time{}, time.Time{}
+}
+
+// Flush immediately makes the pending trailing call, if any, instead of waiting for it.
+// The wrapped function runs in the calling goroutine.
+func (d *Debounced[T]) Flush() {
+	d.mu.Lock()
+	if d.timer == nil {
+		d.mu.Unlock()
+		return
+	}
+	d.timer.Stop()
+	d.generation++
+	d.trailingEdge(d.clock.Now())
+}
+
+// Pending reports whether a trailing call is waiting to be made.
+func (d *Debounced[T]) Pending() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.timer != nil && d.hasArg && d.options.Trailing
+}
+
+// shouldInvoke checks whether a call at the given time starts a new burst or reaches the maximum wait.
+// It must be called with the lock held.
+func (d *Debounced[T]) shouldInvoke(now time.Time) bool {
+	if !d.called {
+		return true
+	}
+	sinceCall := now.Sub(d.lastCall)
+	return sinceCall >= d.wait || sinceCall < 0 || (d.options.MaxWait > 0 && now.Sub(d.lastInvoke) >= d.options.MaxWait)
+}
+
+// schedule starts a timer that calls expire after delay. It must be called with the lock held.
+func (d *Debounced[T]) schedule(delay time.Duration) {
+	d.generation++
+	generation := d.generation
+	d.timer = d.clock.AfterFunc(delay, func() {
+		d.expire(generation)
+	})
+}
+
+// expire handles the end of a timer, either making the trailing call or waiting for the rest of the burst.
+func (d *Debounced[T]) expire(generation int) {
+	d.mu.Lock()
+	if generation != d.generation {
+		d.mu.Unlock()
+		return
+	}
+	now := d.clock.Now()
+	if d.shouldInvoke(now) {
+		d.trailingEdge(now)
+		return
+	}
+
+	remaining := d.wait - now.Sub(d.lastCall)
+	if d.options.MaxWait > 0 {
+		remaining = min(remaining, d.options.MaxWait-now.Sub(d.lastInvoke))
+	}
+	d.schedule(remaining)
+	d.mu.Unlock()
+}
+
+// trailingEdge ends the burst, calling the wrapped function if it has an argument it wasn't called with.
+// It must be called with the lock held, and releases it.
+func (d *Debounced[T]) trailingEdge(now time.Time) {
+	d.timer = nil
+	if d.options.Trailing && d.hasArg {
+		d.invoke(now)
+		return
+	}
+	var zero T
+	d.arg, d.hasArg = zero, false
+	d.mu.Unlock()
+}
+
+// invoke calls the wrapped function with the latest argument. It must be called with the lock held,
+// and releases it before calling the function.
+func (d *Debounced[T]) invoke(now time.Time) {
+	arg := d.arg
+	var zero T
+	d.arg, d.hasArg = zero, false
+	d.lastInvoke = now
+	d.mu.Unlock()
+	d.fn(arg)
+}
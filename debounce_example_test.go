@@ -0,0 +1,59 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+	"strings"
+	"time"
+)
+
+func ExampleDebounce() {
+	clock := godash.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	search := godash.Debounce(func(query string) {
+		fmt.Println("searching for", query)
+	}, 300*time.Millisecond, godash.DebounceOptions{Clock: clock})
+
+	for _, query := range []string{"g", "go", "god", "goda", "godash"} {
+		search.Call(query)
+		clock.Advance(100 * time.Millisecond)
+	}
+	clock.Advance(time.Second)
+	// Output:
+	// searching for godash
+}
+
+func ExampleThrottle() {
+	clock := godash.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	start := clock.Now()
+	save := godash.Throttle(func(n int) {
+		fmt.Printf("saving revision %d at %v\n", n, clock.Now().Sub(start))
+	}, time.Second, clock)
+
+	for n := 1; n <= 5; n++ {
+		save.Call(n)
+		clock.Advance(400 * time.Millisecond)
+	}
+	clock.Advance(time.Second)
+	// Output:
+	// saving revision 1 at 0s
+	// saving revision 3 at 1s
+	// saving revision 5 at 2.2s
+}
+
+func ExampleRateLimit() {
+	clock := godash.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	limiter := godash.RateLimit(func(s string) (string, error) {
+		return strings.ToUpper(s), nil
+	}, 1, 2, clock)
+
+	for _, s := range []string{"a", "b", "c"} {
+		fmt.Println(limiter.TryCall(s))
+	}
+	clock.Advance(time.Second)
+	fmt.Println(limiter.TryCall("d"))
+	// Output:
+	// A <nil>
+	// B <nil>
+	//  rate limit exceeded
+	// D <nil>
+}
@@ -0,0 +1,127 @@
+package godash
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// debounceRecorder records the calls of a debounced function, with the time they were made at.
+type debounceRecorder struct {
+	clock *FakeClock
+	start time.Time
+	calls []string
+}
+
+func newDebounceRecorder() *debounceRecorder {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &debounceRecorder{clock: NewFakeClock(start), start: start, calls: make([]string, 0)}
+}
+
+func (r *debounceRecorder) record(v int) {
+	r.calls = append(r.calls, fmt.Sprintf("%d@%v", v, r.clock.Now().Sub(r.start)))
+}
+
+// callAt calls d at each of the given offsets from the start, in milliseconds, passing the offset as argument.
+func (r *debounceRecorder) callAt(d *Debounced[int], offsets ...int) {
+	for _, offset := range offsets {
+		r.clock.Advance(r.start.Add(time.Duration(offset) * time.Millisecond).Sub(r.clock.Now()))
+		d.Call(offset)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	tests := []struct {
+		name    string
+		options DebounceOptions
+		calls   []int
+		want    []string
+	}{
+		{
+			name:  "trailing by default",
+			calls: []int{0, 50, 100, 300},
+			want:  []string{"100@200ms", "300@400ms"},
+		},
+		{
+			name:    "leading only",
+			options: DebounceOptions{Leading: true},
+			calls:   []int{0, 50, 100, 300},
+			want:    []string{"0@0s", "300@300ms"},
+		},
+		{
+			name:    "leading and trailing",
+			options: DebounceOptions{Leading: true, Trailing: true},
+			calls:   []int{0, 50, 300},
+			want:    []string{"0@0s", "50@150ms", "300@300ms"},
+		},
+		{
+			name:    "max wait",
+			options: DebounceOptions{MaxWait: 250 * time.Millisecond},
+			calls:   []int{0, 60, 120, 180, 240, 300, 360, 420, 480, 540},
+			want:    []string{"240@250ms", "480@500ms", "540@640ms"},
+		},
+		{
+			name:    "max wait shorter than wait",
+			options: DebounceOptions{MaxWait: 10 * time.Millisecond},
+			calls:   []int{0, 60, 120, 180},
+			want:    []string{"60@100ms", "180@220ms"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newDebounceRecorder()
+			tt.options.Clock = r.clock
+			d := Debounce(r.record, 100*time.Millisecond, tt.options)
+
+			r.callAt(d, tt.calls...)
+			r.clock.Advance(time.Second)
+
+			if fmt.Sprint(r.calls) != fmt.Sprint(tt.want) {
+				t.Errorf("calls = %v, want %v", r.calls, tt.want)
+			}
+			if d.Pending() {
+				t.Error("Pending() = true after every call was made")
+			}
+		})
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	r := newDebounceRecorder()
+	d := Throttle(r.record, 100*time.Millisecond, r.clock)
+
+	r.callAt(d, 0, 30, 60, 90, 120, 150, 180, 210)
+	r.clock.Advance(time.Second)
+	r.callAt(d, 1500)
+	r.clock.Advance(time.Second)
+
+	want := []string{"0@0s", "90@100ms", "210@210ms", "1500@1.5s"}
+	if fmt.Sprint(r.calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestDebounced_CancelAndFlush(t *testing.T) {
+	r := newDebounceRecorder()
+	d := Debounce(r.record, 100*time.Millisecond, DebounceOptions{Clock: r.clock})
+
+	r.callAt(d, 0, 50)
+	if !d.Pending() {
+		t.Error("Pending() = false with a trailing call waiting")
+	}
+	d.Cancel()
+	if d.Pending() || r.clock.PendingTimers() != 0 {
+		t.Error("Cancel() didn't discard the trailing call")
+	}
+
+	r.callAt(d, 60, 70)
+	d.Flush()
+	d.Flush()
+	r.clock.Advance(time.Second)
+
+	want := []string{"70@70ms"}
+	if fmt.Sprint(r.calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
@@ -0,0 +1,319 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPatchMismatch is returned by [Patch] when an edit script doesn't fit the slice it is applied to.
+var ErrPatchMismatch = errors.New("edit script doesn't match the slice")
+
+// EditOp is the kind of operation of a [Hunk] in an [EditScript].
+type EditOp int
+
+const (
+	// EditEqual means the elements are present in both slices.
+	EditEqual EditOp = iota
+	// EditDelete means the elements are present only in the first slice.
+	EditDelete
+	// EditInsert means the elements are present only in the second slice.
+	EditInsert
+)
+
+// String returns the name of the operation.
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "Equal"
+	case EditDelete:
+		return "Delete"
+	case EditInsert:
+		return "Insert"
+	default:
+		return fmt.Sprintf("EditOp(%d)", int(op))
+	}
+}
+
+// prefix returns the character used by [EditScript.String] to mark the lines of the operation.
+func (op EditOp) prefix() string {
+	switch op {
+	case EditDelete:
+		return "-"
+	case EditInsert:
+		return "+"
+	default:
+		return " "
+	}
+}
+
+// Hunk is a run of consecutive elements sharing the same [EditOp] in an [EditScript].
+type Hunk[T any] struct {
+	Op     EditOp
+	Values Slice[T]
+}
+
+// EditScript is a sequence of hunks describing how to turn a slice into another one.
+// Applying the Equal and Delete hunks in order consumes the first slice, and the Equal and Insert hunks in order
+// produce the second one.
+type EditScript[T any] []Hunk[T]
+
+// String returns a unified-diff style representation of the script, with one element per line.
+// Elements only in the first slice are prefixed with "-", elements only in the second one with "+",
+// and common elements with a space. The elements are converted to strings using the format "%v".
+func (e EditScript[T]) String() string {
+	var builder strings.Builder
+	for _, hunk := range e {
+		for _, v := range hunk.Values {
+			builder.WriteString(fmt.Sprintf("%s%v\n", hunk.Op.prefix(), v))
+		}
+	}
+	return builder.String()
+}
+
+// HasChanges checks whether the script has any Delete or Insert hunks, i.e. whether the compared slices differ.
+func (e EditScript[T]) HasChanges() bool {
+	return Some(e, func(h Hunk[T]) bool {
+		return h.Op != EditEqual
+	})
+}
+
+// Diff compares a and b and returns the shortest edit script that turns a into b,
+// computed with Myers' O((N+M)D) difference algorithm in linear space.
+func Diff[T comparable](a, b ComparableSlice[T]) EditScript[T] {
+	return DiffWith(a.Slice, b.Slice, equals[T])
+}
+
+// DiffWith behaves like [Diff], except elements are compared with the equal function,
+// so it can be used with slices of any type.
+func DiffWith[T any, S ~[]T](a, b S, equal func(a, b T) bool) EditScript[T] {
+	script := make(EditScript[T], 0)
+	for _, edit := range myersDiff(a, b, equal) {
+		if n := len(script); n > 0 && script[n-1].Op == edit.op {
+			script[n-1].Values = append(script[n-1].Values, edit.value)
+			continue
+		}
+		script = append(script, Hunk[T]{Op: edit.op, Values: Slice[T]{edit.value}})
+	}
+	return script
+}
+
+// edit is a single element operation of an edit script.
+type edit[T any] struct {
+	op    EditOp
+	value T
+}
+
+// myersDiff returns the element operations of the shortest edit script that turns a into b.
+// It follows the linear space variation of the algorithm described in "An O(ND) Difference Algorithm and Its
+// Variations" (Myers, 1986): the middle snake of an optimal path is found by searching from both ends at once,
+// and the parts before and after it are compared recursively, so memory grows with N+M instead of (N+M)D.
+func myersDiff[T any, S ~[]T](a, b S, equal func(a, b T) bool) []edit[T] {
+	size := len(a) + len(b) + 3
+	d := &differ[T, S]{
+		a: a, b: b, equal: equal,
+		forward:  make([]int, size),
+		backward: make([]int, size),
+		edits:    make([]edit[T], 0, len(a)+len(b)),
+	}
+	d.compare(0, len(a), 0, len(b))
+	d.flush()
+	return d.edits
+}
+
+// differ holds the state of [myersDiff]. Deletions and insertions are buffered until the next equal element,
+// so every run of changes lists its deletions first.
+type differ[T any, S ~[]T] struct {
+	a, b     S
+	equal    func(a, b T) bool
+	forward  []int // the furthest x reached on every diagonal from the start, indexed by diagonal+offset
+	backward []int // the furthest x reached on every diagonal from the end, counted from the end
+	edits    []edit[T]
+	deleted  []T
+	inserted []T
+}
+
+// compare adds the edits turning a[aLo:aHi] into b[bLo:bHi].
+func (d *differ[T, S]) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.equal(d.a[aLo], d.b[bLo]) {
+		d.keep(d.a[aLo])
+		aLo, bLo = aLo+1, bLo+1
+	}
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.equal(d.a[aHi-suffix-1], d.b[bHi-suffix-1]) {
+		suffix++
+	}
+	aHi, bHi = aHi-suffix, bHi-suffix
+
+	switch {
+	case aLo == aHi:
+		d.inserted = append(d.inserted, d.b[bLo:bHi]...)
+	case bLo == bHi:
+		d.deleted = append(d.deleted, d.a[aLo:aHi]...)
+	default:
+		x, y, u, v := d.middleSnake(aLo, aHi, bLo, bHi)
+		d.compare(aLo, x, bLo, y)
+		for ; x < u; x++ {
+			d.keep(d.a[x])
+		}
+		d.compare(u, aHi, v, bHi)
+	}
+
+	for i := aHi; i < aHi+suffix; i++ {
+		d.keep(d.a[i])
+	}
+}
+
+// middleSnake returns the start (x, y) and the end (u, v) of the snake in the middle of a shortest edit path
+// turning a[aLo:aHi] into b[bLo:bHi], searching forward from the start and backward from the end at once until
+// the paths overlap.
+func (d *differ[T, S]) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	limit := (n + m + 1) / 2
+	offset := limit + 1
+	d.forward[offset+1], d.backward[offset+1] = 0, 0
+
+	// chooses whether the path to diagonal k in step s comes from diagonal k+1 (an insertion)
+	// or from diagonal k-1 (a deletion)
+	fromAbove := func(furthest []int, k, s int) bool {
+		return k == -s || (k != s && furthest[offset+k-1] < furthest[offset+k+1])
+	}
+
+	for s := 0; s <= limit; s++ {
+		for k := -s; k <= s; k += 2 {
+			var x int
+			if fromAbove(d.forward, k, s) {
+				x = d.forward[offset+k+1]
+			} else {
+				x = d.forward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.equal(d.a[aLo+x], d.b[bLo+y]) {
+				x, y = x+1, y+1
+			}
+			d.forward[offset+k] = x
+			// the backward diagonal delta-k reaches the same points as the forward diagonal k
+			if reverse := delta - k; odd && reverse >= -(s-1) && reverse <= s-1 && x+d.backward[offset+reverse] >= n {
+				return aLo + startX, bLo + startY, aLo + x, bLo + y
+			}
+		}
+
+		for k := -s; k <= s; k += 2 {
+			var x int
+			if fromAbove(d.backward, k, s) {
+				x = d.backward[offset+k+1]
+			} else {
+				x = d.backward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.equal(d.a[aHi-x-1], d.b[bHi-y-1]) {
+				x, y = x+1, y+1
+			}
+			d.backward[offset+k] = x
+			if forward := delta - k; !odd && forward >= -s && forward <= s && x+d.forward[offset+forward] >= n {
+				return aHi - x, bHi - y, aHi - startX, bHi - startY
+			}
+		}
+	}
+	panic("godash: the middle snake of a diff wasn't found")
+}
+
+// keep adds an equal element, after the changes buffered before it.
+func (d *differ[T, S]) keep(value T) {
+	d.flush()
+	d.edits = append(d.edits, edit[T]{op: EditEqual, value: value})
+}
+
+// flush adds the buffered deletions and insertions, in this order.
+func (d *differ[T, S]) flush() {
+	for _, value := range d.deleted {
+		d.edits = append(d.edits, edit[T]{op: EditDelete, value: value})
+	}
+	for _, value := range d.inserted {
+		d.edits = append(d.edits, edit[T]{op: EditInsert, value: value})
+	}
+	d.deleted, d.inserted = d.deleted[:0], d.inserted[:0]
+}
+
+// Patch applies the edit script to a and returns the resulting slice: Equal hunks keep the elements of a,
+// Delete hunks skip them and Insert hunks add their values.
+// The elements of a are not compared with the values of the Equal and Delete hunks, but if the script consumes
+// more or fewer elements than a has, a nil slice and [ErrPatchMismatch] are returned.
+func Patch[T any, S ~[]T](a S, script EditScript[T]) ([]T, error) {
+	result := make([]T, 0, len(a))
+	position := 0
+	for _, hunk := range script {
+		switch hunk.Op {
+		case EditEqual, EditDelete:
+			end := position + len(hunk.Values)
+			if end > len(a) {
+				return nil, fmt.Errorf("%w: the script needs at least %d elements but the slice has %d",
+					ErrPatchMismatch, end, len(a))
+			}
+			if hunk.Op == EditEqual {
+				result = append(result, a[position:end]...)
+			}
+			position = end
+		case EditInsert:
+			result = append(result, hunk.Values...)
+		default:
+			return nil, fmt.Errorf("%w: unknown operation %v", ErrPatchMismatch, hunk.Op)
+		}
+	}
+
+	if position != len(a) {
+		return nil, fmt.Errorf("%w: the script uses %d elements but the slice has %d", ErrPatchMismatch, position, len(a))
+	}
+	return result, nil
+}
+
+// LongestCommonSubsequence returns the longest sequence of elements present in both a and b in the same
+// relative order, though not necessarily contiguously.
+func LongestCommonSubsequence[T comparable](a, b ComparableSlice[T]) Slice[T] {
+	return LongestCommonSubsequenceWith(a.Slice, b.Slice, equals[T])
+}
+
+// LongestCommonSubsequenceWith behaves like [LongestCommonSubsequence], except elements are compared
+// with the equal function. The elements of a are returned.
+func LongestCommonSubsequenceWith[T any, S ~[]T](a, b S, equal func(a, b T) bool) Slice[T] {
+	result := make(Slice[T], 0)
+	for _, hunk := range DiffWith(a, b, equal) {
+		if hunk.Op == EditEqual {
+			result = append(result, hunk.Values...)
+		}
+	}
+	return result
+}
+
+// LevenshteinDistance returns the minimum number of single element insertions, deletions or substitutions
+// needed to turn a into b. To compare strings, convert them to slices of runes.
+func LevenshteinDistance[T comparable](a, b ComparableSlice[T]) int {
+	return LevenshteinDistanceWith(a.Slice, b.Slice, equals[T])
+}
+
+// LevenshteinDistanceWith behaves like [LevenshteinDistance], except elements are compared with the equal function.
+func LevenshteinDistanceWith[T any, S ~[]T](a, b S, equal func(a, b T) bool) int {
+	previous := Range(0, len(b)+1, 1)
+	current := make([]int, len(b)+1)
+	for i := range a {
+		current[0] = i + 1
+		for j := range b {
+			substitution := previous[j]
+			if !equal(a[i], b[j]) {
+				substitution++
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// equals compares two comparable values with the == operator.
+func equals[T comparable](a, b T) bool {
+	return a == b
+}
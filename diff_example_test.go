@@ -0,0 +1,37 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleDiff() {
+	before := godash.NewComparableSlice("host", "port", "timeout")
+	after := godash.NewComparableSlice("host", "port", "retries", "timeout")
+
+	fmt.Print(godash.Diff(before, after))
+	// Output:
+	//  host
+	//  port
+	// +retries
+	//  timeout
+}
+
+func ExamplePatch() {
+	a := []int{1, 2, 3}
+	b := []int{1, 3, 4}
+	script := godash.Diff(godash.NewComparableSlice(a...), godash.NewComparableSlice(b...))
+
+	fmt.Println(godash.Patch(a, script))
+	// Output:
+	// [1 3 4] <nil>
+}
+
+func ExampleLevenshteinDistance() {
+	a := godash.NewComparableSlice([]rune("kitten")...)
+	b := godash.NewComparableSlice([]rune("sitting")...)
+
+	fmt.Println(godash.LevenshteinDistance(a, b))
+	// Output:
+	// 3
+}
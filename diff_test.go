@@ -0,0 +1,327 @@
+package godash
+
+import (
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want EditScript[string]
+	}{{
+		name: "equal slices",
+		a:    []string{"a", "b"},
+		b:    []string{"a", "b"},
+		want: EditScript[string]{{Op: EditEqual, Values: Slice[string]{"a", "b"}}},
+	}, {
+		name: "both empty",
+		a:    []string{},
+		b:    []string{},
+		want: EditScript[string]{},
+	}, {
+		name: "only insertions",
+		a:    []string{},
+		b:    []string{"a", "b"},
+		want: EditScript[string]{{Op: EditInsert, Values: Slice[string]{"a", "b"}}},
+	}, {
+		name: "only deletions",
+		a:    []string{"a", "b"},
+		b:    nil,
+		want: EditScript[string]{{Op: EditDelete, Values: Slice[string]{"a", "b"}}},
+	}, {
+		name: "replaced element",
+		a:    []string{"a", "b", "c"},
+		b:    []string{"a", "x", "c"},
+		want: EditScript[string]{
+			{Op: EditEqual, Values: Slice[string]{"a"}},
+			{Op: EditDelete, Values: Slice[string]{"b"}},
+			{Op: EditInsert, Values: Slice[string]{"x"}},
+			{Op: EditEqual, Values: Slice[string]{"c"}},
+		},
+	}, {
+		// The linear space search finds another script of 5 edits than the one drawn in the paper.
+		name: "example from Myers' paper",
+		a:    strings.Split("ABCABBA", ""),
+		b:    strings.Split("CBABAC", ""),
+		want: EditScript[string]{
+			{Op: EditDelete, Values: Slice[string]{"A"}},
+			{Op: EditInsert, Values: Slice[string]{"C"}},
+			{Op: EditEqual, Values: Slice[string]{"B"}},
+			{Op: EditDelete, Values: Slice[string]{"C"}},
+			{Op: EditEqual, Values: Slice[string]{"A", "B"}},
+			{Op: EditDelete, Values: Slice[string]{"B"}},
+			{Op: EditEqual, Values: Slice[string]{"A"}},
+			{Op: EditInsert, Values: Slice[string]{"C"}},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(NewComparableSlice(tt.a...), NewComparableSlice(tt.b...))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			gotWith := DiffWith(tt.a, tt.b, func(a, b string) bool { return a == b })
+			if !reflect.DeepEqual(gotWith, tt.want) {
+				t.Errorf("DiffWith(%v, %v) = %v, want %v", tt.a, tt.b, gotWith, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffProperties(t *testing.T) {
+	inputs := [][]int{{}, {1}, {1, 2, 3}, {3, 2, 1}, {1, 1, 2, 2}, {2, 1, 2, 1, 3}, {4, 5}, {1, 3, 5, 2, 4}}
+
+	for _, a := range inputs {
+		for _, b := range inputs {
+			script := Diff(NewComparableSlice(a...), NewComparableSlice(b...))
+
+			patched, err := Patch(a, script)
+			if err != nil || !reflect.DeepEqual(patched, append([]int{}, b...)) {
+				t.Errorf("Patch(%v, Diff(%v, %v)) = %v, %v, want %v", a, a, b, patched, err, b)
+			}
+
+			lcs := LongestCommonSubsequence(NewComparableSlice(a...), NewComparableSlice(b...))
+			edits := 0
+			for _, hunk := range script {
+				if hunk.Op != EditEqual {
+					edits += len(hunk.Values)
+				}
+			}
+			if want := len(a) + len(b) - 2*len(lcs); edits != want {
+				t.Errorf("Diff(%v, %v) has %d edits, want %d", a, b, edits, want)
+			}
+			if script.HasChanges() == reflect.DeepEqual(a, b) {
+				t.Errorf("Diff(%v, %v).HasChanges() = %t", a, b, script.HasChanges())
+			}
+		}
+	}
+}
+
+func TestDiffRandom(t *testing.T) {
+	// The number of edits is checked against the length of the longest common subsequence, computed with
+	// dynamic programming.
+	r := rand.New(rand.NewPCG(13, 14))
+	for i := 0; i < 300; i++ {
+		a, b := make([]int, r.IntN(40)), make([]int, r.IntN(40))
+		for j := range a {
+			a[j] = r.IntN(4)
+		}
+		for j := range b {
+			b[j] = r.IntN(4)
+		}
+
+		lengths := make([][]int, len(a)+1)
+		for j := range lengths {
+			lengths[j] = make([]int, len(b)+1)
+		}
+		for x := len(a) - 1; x >= 0; x-- {
+			for y := len(b) - 1; y >= 0; y-- {
+				if a[x] == b[y] {
+					lengths[x][y] = lengths[x+1][y+1] + 1
+				} else {
+					lengths[x][y] = max(lengths[x+1][y], lengths[x][y+1])
+				}
+			}
+		}
+
+		script := Diff(NewComparableSlice(a...), NewComparableSlice(b...))
+		patched, err := Patch(a, script)
+		if err != nil || !slices.Equal(patched, b) {
+			t.Fatalf("Patch(%v, Diff(%v, %v)) = %v, %v", a, a, b, patched, err)
+		}
+		lcs := LongestCommonSubsequence(NewComparableSlice(a...), NewComparableSlice(b...))
+		if len(lcs) != lengths[0][0] {
+			t.Fatalf("LongestCommonSubsequence(%v, %v) = %v, want %d elements", a, b, lcs, lengths[0][0])
+		}
+	}
+}
+
+func TestDiff_LargeDisjointSlices(t *testing.T) {
+	a, b := Range(0, 5000, 1), Range(5000, 10000, 1)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	script := Diff(NewComparableSlice(a...), NewComparableSlice(b...))
+	runtime.ReadMemStats(&after)
+
+	if len(script) != 2 || len(script[0].Values) != 5000 || len(script[1].Values) != 5000 {
+		t.Fatalf("Diff() of disjoint slices = %d hunks, want a deletion and an insertion", len(script))
+	}
+	// Memory grows linearly with the length of the slices, so a few MB are enough.
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 10<<20 {
+		t.Errorf("Diff() allocated %d bytes", allocated)
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	disjointA, disjointB := Range(0, 5000, 1), Range(5000, 10000, 1)
+	similarA, similarB := Range(0, 5000, 1), Range(0, 5000, 1)
+	for i := 0; i < len(similarB); i += 50 {
+		similarB[i] = -i
+	}
+
+	b.Run("disjoint", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Diff(NewComparableSlice(disjointA...), NewComparableSlice(disjointB...))
+		}
+	})
+	b.Run("similar", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Diff(NewComparableSlice(similarA...), NewComparableSlice(similarB...))
+		}
+	})
+}
+
+func TestDiffWith(t *testing.T) {
+	a := []customStruct{{int: 1, string: "a"}, {int: 2, string: "b"}}
+	b := []customStruct{{int: 2, string: "B"}, {int: 3, string: "c"}}
+	sameInt := func(x, y customStruct) bool { return x.int == y.int }
+
+	want := EditScript[customStruct]{
+		{Op: EditDelete, Values: Slice[customStruct]{{int: 1, string: "a"}}},
+		{Op: EditEqual, Values: Slice[customStruct]{{int: 2, string: "b"}}},
+		{Op: EditInsert, Values: Slice[customStruct]{{int: 3, string: "c"}}},
+	}
+	if got := DiffWith(a, b, sameInt); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffWith() = %v, want %v", got, want)
+	}
+}
+
+func TestEditScript_String(t *testing.T) {
+	script := Diff(NewComparableSlice("a", "b", "c"), NewComparableSlice("a", "c", "d"))
+	want := " a\n-b\n c\n+d\n"
+	if got := script.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestEditOp_String(t *testing.T) {
+	tests := []struct {
+		op   EditOp
+		want string
+	}{
+		{op: EditEqual, want: "Equal"},
+		{op: EditDelete, want: "Delete"},
+		{op: EditInsert, want: "Insert"},
+		{op: EditOp(10), want: "EditOp(10)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.op.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       []int
+		script  EditScript[int]
+		want    []int
+		wantErr error
+	}{{
+		name: "apply script",
+		a:    []int{1, 2, 3},
+		script: EditScript[int]{
+			{Op: EditEqual, Values: Slice[int]{1}},
+			{Op: EditDelete, Values: Slice[int]{2}},
+			{Op: EditInsert, Values: Slice[int]{4, 5}},
+			{Op: EditEqual, Values: Slice[int]{3}},
+		},
+		want: []int{1, 4, 5, 3},
+	}, {
+		name:   "empty script on empty slice",
+		a:      []int{},
+		script: EditScript[int]{},
+		want:   []int{},
+	}, {
+		name:    "script longer than the slice",
+		a:       []int{1},
+		script:  EditScript[int]{{Op: EditEqual, Values: Slice[int]{1, 2}}},
+		wantErr: ErrPatchMismatch,
+	}, {
+		name:    "script shorter than the slice",
+		a:       []int{1, 2},
+		script:  EditScript[int]{{Op: EditDelete, Values: Slice[int]{1}}},
+		wantErr: ErrPatchMismatch,
+	}, {
+		name:    "unknown operation",
+		a:       []int{1},
+		script:  EditScript[int]{{Op: EditOp(7), Values: Slice[int]{1}}},
+		wantErr: ErrPatchMismatch,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Patch(tt.a, tt.script)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Patch() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Patch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{name: "common subsequence", a: "ABCBDAB", b: "BDCABA", want: "BCBA"},
+		{name: "no common elements", a: "abc", b: "xyz", want: ""},
+		{name: "equal sequences", a: "abc", b: "abc", want: "abc"},
+		{name: "empty sequence", a: "", b: "abc", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LongestCommonSubsequence(NewComparableSlice([]rune(tt.a)...), NewComparableSlice([]rune(tt.b)...))
+			if len(got) != len(tt.want) {
+				t.Errorf("LongestCommonSubsequence(%q, %q) = %q, want a subsequence as long as %q", tt.a, tt.b, string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "flaw", b: "lawn", want: 2},
+		{a: "", b: "abc", want: 3},
+		{a: "abc", b: "", want: 3},
+		{a: "", b: "", want: 0},
+		{a: "same", b: "same", want: 0},
+		{a: "héllo", b: "hello", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			got := LevenshteinDistance(NewComparableSlice([]rune(tt.a)...), NewComparableSlice([]rune(tt.b)...))
+			if got != tt.want {
+				t.Errorf("LevenshteinDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+
+			gotWith := LevenshteinDistanceWith([]rune(tt.a), []rune(tt.b), func(a, b rune) bool { return a == b })
+			if gotWith != tt.want {
+				t.Errorf("LevenshteinDistanceWith(%q, %q) = %d, want %d", tt.a, tt.b, gotWith, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package godash
+
+// DisjointSet is a union-find structure, grouping elements into disjoint sets that are merged by [DisjointSet.Union].
+// It uses path compression and union by rank, so its operations take nearly constant amortized time.
+//
+// The zero value is an empty DisjointSet. A DisjointSet isn't safe for concurrent use.
+type DisjointSet[T comparable] struct {
+	elements []T       // in the order they were added
+	index    map[T]int // of every element, in elements
+	parent   []int     // the parent of every element, which is itself for the representative of a set
+	rank     []int     // an upper bound of the height of every tree, for representatives
+	size     []int     // the number of elements of every set, for representatives
+}
+
+// NewDisjointSet creates a DisjointSet where each of the elements is in a set of its own.
+func NewDisjointSet[T comparable](elements ...T) *DisjointSet[T] {
+	d := &DisjointSet[T]{}
+	for _, element := range elements {
+		d.MakeSet(element)
+	}
+	return d
+}
+
+// MakeSet adds the element in a set of its own, and returns true. If the element was already added, nothing
+// changes and it returns false.
+func (d *DisjointSet[T]) MakeSet(element T) bool {
+	if _, ok := d.index[element]; ok {
+		return false
+	}
+	if d.index == nil {
+		d.index = map[T]int{}
+	}
+	i := len(d.elements)
+	d.index[element] = i
+	d.elements = append(d.elements, element)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.size = append(d.size, 1)
+	return true
+}
+
+// Union merges the sets of the elements, adding the elements that weren't added yet. It returns false if the
+// elements were already in the same set.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	d.MakeSet(a)
+	d.MakeSet(b)
+	rootA, rootB := d.root(d.index[a]), d.root(d.index[b])
+	if rootA == rootB {
+		return false
+	}
+	if d.rank[rootA] < d.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+	if d.rank[rootA] == d.rank[rootB] {
+		d.rank[rootA]++
+	}
+	return true
+}
+
+// Find returns the representative of the set of the element, which is the same for every element of the set.
+// If the element wasn't added, it returns the zero value of type `T` and `false`.
+func (d *DisjointSet[T]) Find(element T) (T, bool) {
+	i, ok := d.index[element]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return d.elements[d.root(i)], true
+}
+
+// Connected checks whether the elements were added and are in the same set.
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, okA := d.index[a]
+	j, okB := d.index[b]
+	return okA && okB && d.root(i) == d.root(j)
+}
+
+// Size returns the number of elements in the set of the element, or 0 if the element wasn't added.
+func (d *DisjointSet[T]) Size(element T) int {
+	i, ok := d.index[element]
+	if !ok {
+		return 0
+	}
+	return d.size[d.root(i)]
+}
+
+// Groups returns every set of elements, ordered by the first element of each set to be added.
+func (d *DisjointSet[T]) Groups() []Set[T] {
+	groups := make(map[int]Set[T])
+	var result []Set[T]
+	for i, element := range d.elements {
+		root := d.root(i)
+		group, ok := groups[root]
+		if !ok {
+			group = make(Set[T], d.size[root])
+			groups[root] = group
+			result = append(result, group)
+		}
+		group.Add(element)
+	}
+	return result
+}
+
+// root returns the index of the representative of the set of the element at index i, making every element on
+// the way point to it.
+func (d *DisjointSet[T]) root(i int) int {
+	root := i
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	for i != root {
+		next := d.parent[i]
+		d.parent[i] = root
+		i = next
+	}
+	return root
+}
+
+// ClusterBy groups the elements of the slice into clusters, where the two elements of every edge are in the same
+// cluster, transitively. Elements without edges are in clusters of their own, and edges with an element that
+// isn't in the slice are ignored. The clusters are ordered by the first element of each one in the slice.
+func ClusterBy[T comparable, S ~[]T](s S, edges [][2]T) []Set[T] {
+	d := NewDisjointSet(s...)
+	for _, edge := range edges {
+		if _, ok := d.index[edge[0]]; !ok {
+			continue
+		}
+		if _, ok := d.index[edge[1]]; !ok {
+			continue
+		}
+		d.Union(edge[0], edge[1])
+	}
+	return d.Groups()
+}
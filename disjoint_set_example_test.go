@@ -0,0 +1,25 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleDisjointSet() {
+	accounts := godash.NewDisjointSet("ana", "bob", "carla", "dan")
+	accounts.Union("ana", "bob")
+	accounts.Union("bob", "carla")
+
+	fmt.Println(accounts.Connected("ana", "carla"), accounts.Size("ana"))
+	fmt.Println(accounts.Groups())
+	// Output:
+	// true 3
+	// [set{ana, bob, carla} set{dan}]
+}
+
+func ExampleClusterBy() {
+	duplicates := [][2]string{{"A", "B"}, {"B", "C"}, {"D", "E"}}
+	fmt.Println(godash.ClusterBy([]string{"A", "B", "C", "D", "E", "F"}, duplicates))
+	// Output:
+	// [set{A, B, C} set{D, E} set{F}]
+}
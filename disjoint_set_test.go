@@ -0,0 +1,84 @@
+package godash
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestDisjointSet(t *testing.T) {
+	var d DisjointSet[string]
+	if _, ok := d.Find("a"); ok || d.Size("a") != 0 || d.Connected("a", "a") || len(d.Groups()) != 0 {
+		t.Error("the zero value isn't an empty DisjointSet")
+	}
+
+	if !d.MakeSet("a") || d.MakeSet("a") {
+		t.Error("MakeSet() didn't report whether the element was added")
+	}
+	if !d.Union("a", "b") || !d.Union("c", "d") || d.Union("b", "a") {
+		t.Error("Union() didn't report whether the sets were merged")
+	}
+	d.MakeSet("e")
+
+	if !d.Connected("a", "b") || d.Connected("a", "c") || d.Connected("a", "x") {
+		t.Error("Connected() doesn't match the unions")
+	}
+	d.Union("b", "d")
+	rootA, okA := d.Find("a")
+	rootC, okC := d.Find("c")
+	if !okA || !okC || rootA != rootC || d.Size("c") != 4 || d.Size("e") != 1 {
+		t.Errorf("Find() = %q and %q, and Size(c) = %d, want the same representative and 4", rootA, rootC, d.Size("c"))
+	}
+
+	want := []Set[string]{NewSet("a", "b", "c", "d"), NewSet("e")}
+	if got := d.Groups(); !slices.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+}
+
+func TestDisjointSet_Random(t *testing.T) {
+	// Unions are checked against a naive labeling of every element with its group.
+	r := rand.New(rand.NewPCG(11, 12))
+	d := NewDisjointSet(Range(0, 200, 1)...)
+	labels := Range(0, 200, 1)
+
+	for step := 0; step < 2000; step++ {
+		a, b := r.IntN(200), r.IntN(200)
+		if r.IntN(2) == 0 {
+			merged := labels[a] != labels[b]
+			if d.Union(a, b) != merged {
+				t.Fatalf("Union(%d, %d) != %t", a, b, merged)
+			}
+			old := labels[b]
+			for i, label := range labels {
+				if label == old {
+					labels[i] = labels[a]
+				}
+			}
+		}
+
+		size := 0
+		for _, label := range labels {
+			if label == labels[a] {
+				size++
+			}
+		}
+		if d.Connected(a, b) != (labels[a] == labels[b]) || d.Size(a) != size {
+			t.Fatalf("Connected(%d, %d) = %t and Size(%d) = %d, want %t and %d",
+				a, b, d.Connected(a, b), a, d.Size(a), labels[a] == labels[b], size)
+		}
+	}
+}
+
+func TestClusterBy(t *testing.T) {
+	edges := [][2]string{{"A", "B"}, {"B", "C"}, {"E", "x"}, {"D", "F"}}
+	got := ClusterBy([]string{"A", "B", "C", "D", "E", "F"}, edges)
+	want := []Set[string]{NewSet("A", "B", "C"), NewSet("D", "F"), NewSet("E")}
+	if !slices.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("ClusterBy() = %v, want %v", got, want)
+	}
+	if got := ClusterBy(Slice[int]{}, nil); len(got) != 0 {
+		t.Errorf("ClusterBy() of an empty slice = %v, want no clusters", got)
+	}
+}
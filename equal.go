@@ -0,0 +1,336 @@
+package godash
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Mismatch is a single difference found by [Equal]: the values of a and b at the same path.
+type Mismatch struct {
+	// Path locates the values from the compared values, like ".Items[3].Name" or `.Labels["env"]`.
+	// It is empty if the compared values themselves differ.
+	Path string
+	// A and B are the formatted values of a and b, or "<missing>" if the path doesn't exist in one of them.
+	A, B string
+}
+
+// String describes the mismatch, like `.Items[3].Name: "a" != "b"`.
+func (m Mismatch) String() string {
+	if m.Path == "" {
+		return m.A + " != " + m.B
+	}
+	return fmt.Sprintf("%s: %s != %s", m.Path, m.A, m.B)
+}
+
+// Difference is the report returned by [Equal], holding every mismatch found between two values.
+// It is empty if the values are equal.
+type Difference []Mismatch
+
+// String describes every mismatch, one per line.
+func (d Difference) String() string {
+	return strings.Join(MustMap(d, Mismatch.String), "\n")
+}
+
+// missingValue is used in a Mismatch for a path that doesn't exist in one of the values.
+const missingValue = "<missing>"
+
+// EqualOption configures how [Equal] compares values.
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	ignoreUnexported bool
+	unorderedSlices  bool
+	floatTolerance   float64
+	comparers        map[reflect.Type]func(a, b reflect.Value) bool
+}
+
+// IgnoreUnexported makes [Equal] skip the unexported fields of structs.
+func IgnoreUnexported() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreUnexported = true
+	}
+}
+
+// UnorderedSlices makes [Equal] compare slices, such as Slice[T], as multisets: they are equal if every element
+// of one matches a different element of the other, whatever their order. Elements are matched greedily, in order,
+// so with a float tolerance a match may be missed if an element is close to several others.
+func UnorderedSlices() EqualOption {
+	return func(o *equalOptions) {
+		o.unorderedSlices = true
+	}
+}
+
+// FloatTolerance makes [Equal] consider floating-point and complex numbers equal if their difference is at most
+// tolerance.
+func FloatTolerance(tolerance float64) EqualOption {
+	return func(o *equalOptions) {
+		o.floatTolerance = tolerance
+	}
+}
+
+// WithComparer makes [Equal] compare the values of type T with the equal function instead of comparing them
+// structurally. It isn't used for unexported struct fields, which can't be passed to a function, nor for the
+// elements of sets, which are always compared as map keys.
+func WithComparer[T any](equal func(a, b T) bool) EqualOption {
+	return func(o *equalOptions) {
+		if o.comparers == nil {
+			o.comparers = make(map[reflect.Type]func(a, b reflect.Value) bool)
+		}
+		o.comparers[reflect.TypeFor[T]()] = func(a, b reflect.Value) bool {
+			return equal(a.Interface().(T), b.Interface().(T))
+		}
+	}
+}
+
+// Equal compares a and b deeply, like reflect.DeepEqual, and returns a report of every mismatch found, which is
+// empty if they are equal. Unlike reflect.DeepEqual, nil and empty slices and maps are equal, and sets, i.e.
+// maps whose values are empty structs such as Set[T], are compared as sets. The options relax the comparison.
+//
+// Functions are equal only if both are nil. Cycles are handled: values already being compared are assumed equal.
+func Equal[T any](a, b T, options ...EqualOption) Difference {
+	e := equaler{visited: NewSet[equalVisit](), diff: make(Difference, 0)}
+	for _, option := range options {
+		option(&e.options)
+	}
+	e.compare(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem(), "")
+	return e.diff
+}
+
+// equalVisit identifies a pair of pointers, maps or slices already being compared.
+type equalVisit struct {
+	a, b uintptr
+	t    reflect.Type
+}
+
+// equaler compares values, collecting their mismatches.
+type equaler struct {
+	options equalOptions
+	visited Set[equalVisit]
+	diff    Difference
+}
+
+// compare compares a and b, which have the same type, adding their mismatches at the given path.
+func (e *equaler) compare(a, b reflect.Value, path string) {
+	if comparer, ok := e.options.comparers[a.Type()]; ok && a.CanInterface() {
+		if !comparer(a, b) {
+			e.mismatch(path, formatValue(a), formatValue(b))
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				e.mismatch(path, formatValue(a), formatValue(b))
+			}
+			return
+		}
+		if e.visit(a, b) {
+			e.compare(a.Elem(), b.Elem(), path)
+		}
+	case reflect.Interface:
+		switch {
+		case a.IsNil() || b.IsNil():
+			if a.IsNil() != b.IsNil() {
+				e.mismatch(path, formatValue(a), formatValue(b))
+			}
+		case a.Elem().Type() != b.Elem().Type():
+			e.mismatch(path, formatTypedValue(a.Elem()), formatTypedValue(b.Elem()))
+		default:
+			e.compare(a.Elem(), b.Elem(), path)
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if field := a.Type().Field(i); field.IsExported() || !e.options.ignoreUnexported {
+				e.compare(a.Field(i), b.Field(i), path+"."+field.Name)
+			}
+		}
+	case reflect.Array:
+		e.compareSequences(a, b, path)
+	case reflect.Slice:
+		if a.Len() > 0 && b.Len() > 0 && !e.visit(a, b) {
+			return
+		}
+		if e.options.unorderedSlices {
+			e.compareUnordered(a, b, path)
+		} else {
+			e.compareSequences(a, b, path)
+		}
+	case reflect.Map:
+		if a.Len() > 0 && b.Len() > 0 && !e.visit(a, b) {
+			return
+		}
+		if t := a.Type().Elem(); t.Kind() == reflect.Struct && t.NumField() == 0 {
+			e.compareSets(a, b, path)
+		} else {
+			e.compareMaps(a, b, path)
+		}
+	case reflect.Float32, reflect.Float64:
+		if x, y := a.Float(), b.Float(); x != y && !(math.Abs(x-y) <= e.options.floatTolerance) {
+			e.mismatch(path, formatValue(a), formatValue(b))
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if x, y := a.Complex(), b.Complex(); x != y && !(cmplx.Abs(x-y) <= e.options.floatTolerance) {
+			e.mismatch(path, formatValue(a), formatValue(b))
+		}
+	case reflect.Func:
+		if !a.IsNil() || !b.IsNil() {
+			e.mismatch(path, formatValue(a), formatValue(b))
+		}
+	default:
+		if !equalScalars(a, b) {
+			e.mismatch(path, formatValue(a), formatValue(b))
+		}
+	}
+}
+
+// visit registers that a and b are being compared, returning false if they already were or if they are the same.
+func (e *equaler) visit(a, b reflect.Value) bool {
+	if a.Pointer() == b.Pointer() && (a.Kind() != reflect.Slice || a.Len() == b.Len()) {
+		return false // the values are the same
+	}
+	key := equalVisit{a: a.Pointer(), b: b.Pointer(), t: a.Type()}
+	if e.visited.Has(key) {
+		return false
+	}
+	e.visited.Add(key)
+	return true
+}
+
+// equalScalars compares booleans, numbers, strings, channels and unsafe pointers, which can be read even from
+// unexported struct fields.
+func equalScalars(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.String:
+		return a.String() == b.String()
+	default:
+		return a.Pointer() == b.Pointer()
+	}
+}
+
+// compareSequences compares the elements of slices or arrays index by index. The elements past the end of the
+// shorter one are reported as missing from it.
+func (e *equaler) compareSequences(a, b reflect.Value, path string) {
+	for i := 0; i < max(a.Len(), b.Len()); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= a.Len():
+			e.mismatch(elementPath, missingValue, formatValue(b.Index(i)))
+		case i >= b.Len():
+			e.mismatch(elementPath, formatValue(a.Index(i)), missingValue)
+		default:
+			e.compare(a.Index(i), b.Index(i), elementPath)
+		}
+	}
+}
+
+// compareUnordered matches every element of a with an equal element of b. The elements left without a match are
+// reported as missing from the other slice.
+func (e *equaler) compareUnordered(a, b reflect.Value, path string) {
+	matched := make([]bool, b.Len())
+	for i := 0; i < a.Len(); i++ {
+		j := 0
+		for ; j < b.Len(); j++ {
+			if !matched[j] && e.equalValues(a.Index(i), b.Index(j)) {
+				break
+			}
+		}
+		if j < b.Len() {
+			matched[j] = true
+		} else {
+			e.mismatch(fmt.Sprintf("%s[%d]", path, i), formatValue(a.Index(i)), missingValue)
+		}
+	}
+	for j, ok := range matched {
+		if !ok {
+			e.mismatch(fmt.Sprintf("%s[%d]", path, j), missingValue, formatValue(b.Index(j)))
+		}
+	}
+}
+
+// equalValues checks whether a and b are equal with the same options, without reporting their mismatches.
+func (e *equaler) equalValues(a, b reflect.Value) bool {
+	inner := equaler{options: e.options, visited: NewSet[equalVisit](), diff: make(Difference, 0)}
+	for key := range e.visited {
+		inner.visited.Add(key)
+	}
+	inner.compare(a, b, "")
+	return len(inner.diff) == 0
+}
+
+// compareMaps compares the values of the keys of both maps, in the order of their formatted keys, and reports the
+// keys missing from one of them.
+func (e *equaler) compareMaps(a, b reflect.Value, path string) {
+	for _, key := range sortedKeys(a, b) {
+		keyPath := fmt.Sprintf("%s[%s]", path, formatValue(key))
+		x, y := a.MapIndex(key), b.MapIndex(key)
+		switch {
+		case !x.IsValid():
+			e.mismatch(keyPath, missingValue, formatValue(y))
+		case !y.IsValid():
+			e.mismatch(keyPath, formatValue(x), missingValue)
+		default:
+			e.compare(x, y, keyPath)
+		}
+	}
+}
+
+// compareSets reports the elements present in only one of the sets.
+func (e *equaler) compareSets(a, b reflect.Value, path string) {
+	for _, key := range sortedKeys(a, b) {
+		switch {
+		case !a.MapIndex(key).IsValid():
+			e.mismatch(path, missingValue, formatValue(key))
+		case !b.MapIndex(key).IsValid():
+			e.mismatch(path, formatValue(key), missingValue)
+		}
+	}
+}
+
+// mismatch adds a mismatch to the report.
+func (e *equaler) mismatch(path, a, b string) {
+	e.diff = append(e.diff, Mismatch{Path: path, A: a, B: b})
+}
+
+// sortedKeys returns the keys of both maps without duplicates, sorted by their formatted values so reports are
+// deterministic.
+func sortedKeys(a, b reflect.Value) []reflect.Value {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortStableFunc(keys, func(x, y reflect.Value) int {
+		return cmp.Compare(formatValue(x), formatValue(y))
+	})
+	return keys
+}
+
+// formatValue formats a value for a report, quoting strings. Unexported values are formatted too.
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return fmt.Sprintf("%q", v)
+	}
+	if isNillable(v.Kind()) && v.IsNil() {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// formatTypedValue formats a value followed by its type, to report values of different types.
+func formatTypedValue(v reflect.Value) string {
+	return fmt.Sprintf("%s (%v)", formatValue(v), v.Type())
+}
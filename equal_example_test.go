@@ -0,0 +1,23 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleEqual() {
+	type Item struct {
+		Name  string
+		Price float64
+	}
+	type Order struct {
+		Items godash.Slice[Item]
+		Tags  godash.Set[string]
+	}
+	a := Order{Items: godash.Slice[Item]{{"pen", 1.5}, {"ink", 3}}, Tags: godash.NewSet("paid")}
+	b := Order{Items: godash.Slice[Item]{{"ink", 3}, {"pen", 1.5000001}}, Tags: godash.NewSet("paid", "sent")}
+
+	fmt.Println(godash.Equal(a, b, godash.UnorderedSlices(), godash.FloatTolerance(1e-6)))
+	// Output:
+	// .Tags: <missing> != "sent"
+}
@@ -0,0 +1,164 @@
+package godash
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+type equalOrder struct {
+	ID     int
+	Items  []equalItem
+	Tags   Set[string]
+	Labels map[string]string
+	Extra  any
+	Next   *equalOrder
+	notes  string
+}
+
+type equalItem struct {
+	Name  string
+	Price float64
+}
+
+func TestEqual(t *testing.T) {
+	base := func() equalOrder {
+		return equalOrder{
+			ID:     1,
+			Items:  []equalItem{{Name: "pen", Price: 1.5}, {Name: "ink", Price: 3}},
+			Tags:   NewSet("new", "paid"),
+			Labels: map[string]string{"env": "prod"},
+			Extra:  42,
+			notes:  "fragile",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		change  func(o *equalOrder)
+		options []EqualOption
+		want    string
+	}{{
+		name:   "equal values",
+		change: func(o *equalOrder) {},
+	}, {
+		name:   "nil and empty collections are equal",
+		change: func(o *equalOrder) { o.Labels = map[string]string{"env": "prod"} },
+	}, {
+		name:   "nested field",
+		change: func(o *equalOrder) { o.Items[1].Name = "nib" },
+		want:   `.Items[1].Name: "ink" != "nib"`,
+	}, {
+		name:   "missing elements",
+		change: func(o *equalOrder) { o.Items = append(o.Items, equalItem{Name: "pad"}) },
+		want:   `.Items[2]: <missing> != {pad 0}`,
+	}, {
+		name:   "sets are compared as sets",
+		change: func(o *equalOrder) { o.Tags = NewSet("paid", "shipped") },
+		want:   `.Tags: "new" != <missing>` + "\n" + `.Tags: <missing> != "shipped"`,
+	}, {
+		name: "map keys",
+		change: func(o *equalOrder) {
+			o.Labels["env"] = "dev"
+			o.Labels["team"] = "core"
+		},
+		want: `.Labels["env"]: "prod" != "dev"` + "\n" + `.Labels["team"]: <missing> != "core"`,
+	}, {
+		name:   "interfaces holding different types",
+		change: func(o *equalOrder) { o.Extra = "42" },
+		want:   `.Extra: 42 (int) != "42" (string)`,
+	}, {
+		name:   "nil pointer",
+		change: func(o *equalOrder) { o.Next = &equalOrder{} },
+		want:   ".Next: <nil> != &{0 [] set{} map[] <nil> <nil> }",
+	}, {
+		name:   "unexported fields",
+		change: func(o *equalOrder) { o.notes = "" },
+		want:   `.notes: "fragile" != ""`,
+	}, {
+		name:    "ignored unexported fields",
+		change:  func(o *equalOrder) { o.notes = "" },
+		options: []EqualOption{IgnoreUnexported()},
+	}, {
+		name:   "ordered slices",
+		change: func(o *equalOrder) { o.Items[0], o.Items[1] = o.Items[1], o.Items[0] },
+		want: `.Items[0].Name: "pen" != "ink"` + "\n.Items[0].Price: 1.5 != 3\n" +
+			`.Items[1].Name: "ink" != "pen"` + "\n.Items[1].Price: 3 != 1.5",
+	}, {
+		name:    "unordered slices",
+		change:  func(o *equalOrder) { o.Items[0], o.Items[1] = o.Items[1], o.Items[0] },
+		options: []EqualOption{UnorderedSlices()},
+	}, {
+		name: "unordered slices with different elements",
+		change: func(o *equalOrder) {
+			o.Items = []equalItem{{Name: "ink", Price: 3}, {Name: "pad", Price: 2}}
+		},
+		options: []EqualOption{UnorderedSlices()},
+		want:    ".Items[0]: {pen 1.5} != <missing>\n.Items[1]: <missing> != {pad 2}",
+	}, {
+		name:   "floats without tolerance",
+		change: func(o *equalOrder) { o.Items[0].Price += 1e-9 },
+		want:   ".Items[0].Price: 1.5 != 1.500000001",
+	}, {
+		name:    "floats with tolerance",
+		change:  func(o *equalOrder) { o.Items[0].Price += 1e-9 },
+		options: []EqualOption{FloatTolerance(1e-6)},
+	}, {
+		name:   "custom comparer",
+		change: func(o *equalOrder) { o.Items[1].Name = "INK" },
+		options: []EqualOption{WithComparer(func(a, b equalItem) bool {
+			return strings.EqualFold(a.Name, b.Name)
+		})},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := base()
+			tt.change(&changed)
+			if got := Equal(base(), changed, tt.options...).String(); got != tt.want {
+				t.Errorf("Equal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		diff Difference
+		want string
+	}{
+		{"scalars", Equal(1, 2), "1 != 2"},
+		{"nil interfaces", Equal[any](nil, nil), ""},
+		{"nil and non nil interfaces", Equal[any](nil, []int{}), "<nil> != []"},
+		{"NaN", Equal(math.NaN(), math.NaN()), "NaN != NaN"},
+		{"arrays", Equal([2]string{"a", "b"}, [2]string{"a", "c"}), `[1]: "b" != "c"`},
+		{"complex with tolerance", Equal(1+1i, 1+1.001i, FloatTolerance(0.01)), ""},
+		{"nil functions", Equal[func()](nil, nil), ""},
+		{"Slice", Equal(Slice[int]{1, 2, 3}, Slice[int]{3, 1, 2}, UnorderedSlices()), ""},
+		{"repeated elements", Equal(Slice[int]{1, 1, 2}, Slice[int]{1, 2, 2}, UnorderedSlices()), "[1]: 1 != <missing>\n[2]: <missing> != 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.diff.String(); got != tt.want {
+				t.Errorf("Equal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual_Cycles(t *testing.T) {
+	newCycle := func(id int) *equalOrder {
+		first := &equalOrder{ID: id}
+		first.Next = &equalOrder{ID: 2, Next: first}
+		return first
+	}
+
+	if diff := Equal(newCycle(1), newCycle(1)); len(diff) != 0 {
+		t.Errorf("Equal() = %v, want no differences", diff)
+	}
+	if got, want := Equal(newCycle(1), newCycle(3)).String(), ".ID: 1 != 3"; got != want {
+		t.Errorf("Equal() = %q, want %q", got, want)
+	}
+}
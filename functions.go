@@ -20,3 +20,89 @@ func MapperToMustMapper[TInput any, TOutput any](mapper Mapper[TInput, TOutput])
 		return result
 	}
 }
+
+// ToPredicate converts a function returning a boolean, such as unicode.IsUpper, to a Predicate.
+// Along with [ToMapper] and [ToMustMapper], it helps composing standard library functions with the helpers below,
+// whose results are plain functions, when a named function type is needed.
+func ToPredicate[T any](f func(T) bool) Predicate[T] {
+	return f
+}
+
+// ToMapper converts a function returning a value and an error, such as strconv.Atoi, to a Mapper.
+func ToMapper[TInput any, TOutput any](f func(TInput) (TOutput, error)) Mapper[TInput, TOutput] {
+	return f
+}
+
+// ToMustMapper converts a function returning a single value, such as strings.ToUpper, to a MustMapper.
+func ToMustMapper[TInput any, TOutput any](f func(TInput) TOutput) MustMapper[TInput, TOutput] {
+	return f
+}
+
+// Partial fixes the first argument of a two-argument function, returning a function of the second one.
+// For example, Partial(strings.Contains, "godash") checks whether "godash" contains its argument.
+func Partial[A any, B any, R any](f func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return f(a, b)
+	}
+}
+
+// PartialRight fixes the second argument of a two-argument function, returning a function of the first one.
+// For example, PartialRight(strings.HasPrefix, "go") checks whether its argument starts with "go".
+func PartialRight[A any, B any, R any](f func(A, B) R, b B) func(A) R {
+	return func(a A) R {
+		return f(a, b)
+	}
+}
+
+// Partial3 fixes the first argument of a three-argument function, returning a function of the other two.
+func Partial3[A any, B any, C any, R any](f func(A, B, C) R, a A) func(B, C) R {
+	return func(b B, c C) R {
+		return f(a, b, c)
+	}
+}
+
+// PartialRight3 fixes the last argument of a three-argument function, returning a function of the other two.
+// For example, PartialRight3(strings.ReplaceAll, "") removes every occurrence of its second argument from the first.
+func PartialRight3[A any, B any, C any, R any](f func(A, B, C) R, c C) func(A, B) R {
+	return func(a A, b B) R {
+		return f(a, b, c)
+	}
+}
+
+// Flip returns a function that calls f with its two arguments swapped.
+func Flip[A any, B any, R any](f func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return f(a, b)
+	}
+}
+
+// Curry2 converts a two-argument function into a chain of functions taking one argument each,
+// so Curry2(f)(a)(b) returns f(a, b).
+func Curry2[A any, B any, R any](f func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return Partial(f, a)
+	}
+}
+
+// Curry3 converts a three-argument function into a chain of functions taking one argument each,
+// so Curry3(f)(a)(b)(c) returns f(a, b, c).
+func Curry3[A any, B any, C any, R any](f func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return Curry2(Partial3(f, a))
+	}
+}
+
+// Uncurry reverses [Curry2], converting a chain of functions taking one argument each into a two-argument function.
+func Uncurry[A any, B any, R any](f func(A) func(B) R) func(A, B) R {
+	return func(a A, b B) R {
+		return f(a)(b)
+	}
+}
+
+// Uncurry3 reverses [Curry3], converting a chain of functions taking one argument each into a three-argument
+// function.
+func Uncurry3[A any, B any, C any, R any](f func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return f(a)(b)(c)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/taciogt/godash"
 	"strconv"
+	"strings"
 )
 
 func ExampleMapperToMustMapper() {
@@ -20,3 +21,25 @@ func ExampleMapperToMustMapper() {
 	// [0 2 4 6 8] <nil>
 	// [0 2 4 6 8]
 }
+
+func ExamplePartialRight() {
+	packages := []string{"go/ast", "net/http", "go/parser", "os"}
+	fmt.Println(godash.Filter(packages, godash.PartialRight(strings.HasPrefix, "go/")))
+	// Output:
+	// [go/ast go/parser]
+}
+
+func ExampleCurry2() {
+	indent := godash.Curry2(strings.Repeat)(" ")
+	fmt.Printf("%q\n", godash.MustMap([]int{0, 2, 4}, indent))
+	// Output:
+	// ["" "  " "    "]
+}
+
+func ExampleToMapper() {
+	fmt.Println(godash.Map([]string{"1", "10", "100"}, godash.ToMapper(strconv.Atoi)))
+	fmt.Println(godash.MustMap([]string{"a", "b"}, godash.ToMustMapper(strings.ToUpper)))
+	// Output:
+	// [1 10 100] <nil>
+	// [A B]
+}
@@ -2,7 +2,11 @@ package godash
 
 import (
 	"errors"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"unicode"
 )
 
 func TestMapperToMustMapper(t *testing.T) {
@@ -59,3 +63,82 @@ func TestMapperToMustMapper(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapters(t *testing.T) {
+	isUpper := ToPredicate(unicode.IsUpper)
+	if got := string(Filter([]rune("GoDash"), isUpper)); got != "GD" {
+		t.Errorf("Filter() = %q, want \"GD\"", got)
+	}
+
+	atoi := ToMapper(strconv.Atoi)
+	if got, err := Map([]string{"1", "2"}, atoi); err != nil || !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Map() = %v, %v, want [1 2], nil", got, err)
+	}
+	if _, err := Map([]string{"x"}, atoi); err == nil {
+		t.Error("Map() didn't return the error of strconv.Atoi")
+	}
+
+	upper := ToMustMapper(strings.ToUpper)
+	if got := MustMap([]string{"a", "b"}, upper); !slices.Equal(got, []string{"A", "B"}) {
+		t.Errorf("MustMap() = %v, want [A B]", got)
+	}
+}
+
+func TestPartial(t *testing.T) {
+	words := []string{"go", "godash", "lodash", "golang"}
+
+	tests := []struct {
+		name string
+		p    Predicate[string]
+		want []string
+	}{{
+		name: "Partial",
+		p:    Partial(strings.Contains, "godash"),
+		want: []string{"go", "godash"},
+	}, {
+		name: "PartialRight",
+		p:    PartialRight(strings.HasPrefix, "go"),
+		want: []string{"go", "godash", "golang"},
+	}, {
+		name: "Flip",
+		p:    Partial(Flip(strings.HasSuffix), "dash"),
+		want: []string{"godash", "lodash"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Filter(words, tt.p); !slices.Equal(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("Partial3 and PartialRight3", func(t *testing.T) {
+		clamp := func(low, high, v int) int { return min(max(v, low), high) }
+		if got := Partial3(clamp, 0)(10, 15); got != 10 {
+			t.Errorf("Partial3() = %d, want 10", got)
+		}
+		removeAll := PartialRight3(strings.ReplaceAll, "")
+		if got := removeAll("go-dash", "-"); got != "godash" {
+			t.Errorf("PartialRight3() = %q, want \"godash\"", got)
+		}
+	})
+}
+
+func TestCurry(t *testing.T) {
+	repeat := Curry2(strings.Repeat)
+	if got := MustMap([]int{1, 2, 3}, repeat("ab")); !slices.Equal(got, []string{"ab", "abab", "ababab"}) {
+		t.Errorf("MustMap() = %v, want [ab abab ababab]", got)
+	}
+	if got := Uncurry(repeat)("x", 3); got != "xxx" {
+		t.Errorf("Uncurry() = %q, want \"xxx\"", got)
+	}
+
+	replace := Curry3(strings.ReplaceAll)
+	if got := replace("a-b-c")("-")("+"); got != "a+b+c" {
+		t.Errorf("Curry3() = %q, want \"a+b+c\"", got)
+	}
+	if got := Uncurry3(replace)("a-b", "-", ""); got != "ab" {
+		t.Errorf("Uncurry3() = %q, want \"ab\"", got)
+	}
+}
@@ -0,0 +1,104 @@
+package godash
+
+import (
+	"math"
+	"reflect"
+)
+
+// Relative tolerances used by [Range] to decide whether a floating-point end value has been reached,
+// so rounding errors don't add or drop an element at the end of the range.
+const (
+	float32RangeTolerance = 1e-6
+	float64RangeTolerance = 1e-9
+)
+
+// Range returns a Slice with the numbers progressing from start up to, but not including, end.
+// A negative step creates a descending range. If step is zero or doesn't move start towards end, or if any
+// argument is a NaN or an infinity, an empty Slice is returned.
+//
+// Elements are computed as start + i*step instead of being accumulated, so floating-point ranges don't
+// drift, and an end value that differs from the last step only by rounding errors is still excluded:
+//
+//	Range(0, 0.3, 0.1) // [0 0.1 0.2]
+func Range[T Number](start, end, step T) Slice[T] {
+	if !isFinite(start) || !isFinite(end) || !isFinite(step) {
+		return Slice[T]{}
+	}
+	if step == 0 || (step > 0 && start >= end) || (step < 0 && start <= end) {
+		return Slice[T]{}
+	}
+
+	count := rangeCount(start, end, step)
+	result := make(Slice[T], count)
+	for i := range result {
+		result[i] = start + T(i)*step
+	}
+	return result
+}
+
+// rangeCount returns how many elements a range from start to end (exclusive) has for the given step.
+// It expects step to move start towards end.
+func rangeCount[T Number](start, end, step T) int {
+	switch reflect.TypeOf(step).Kind() {
+	case reflect.Float32:
+		return floatRangeCount(float64(end-start)/float64(step), float32RangeTolerance)
+	case reflect.Float64:
+		return floatRangeCount(float64(end-start)/float64(step), float64RangeTolerance)
+	default:
+		// the distance is computed with unsigned arithmetic so ranges spanning more than half of
+		// a signed type (e.g. from -100 to 100 for int8) don't overflow
+		span, stepSize := uint64(end)-uint64(start), uint64(step)
+		if step < 0 {
+			span, stepSize = uint64(start)-uint64(end), -uint64(step)
+		}
+		count := span / stepSize
+		if span%stepSize != 0 {
+			count++
+		}
+		return int(count)
+	}
+}
+
+// isFinite checks whether the number is neither a NaN nor an infinity, which is always true for integers.
+func isFinite[T Number](n T) bool {
+	f := float64(n)
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// floatRangeCount rounds quotient up to the number of elements in a floating-point range,
+// snapping it to the nearest integer when they differ by less than the relative tolerance.
+func floatRangeCount(quotient, tolerance float64) int {
+	rounded := math.Round(quotient)
+	if math.Abs(quotient-rounded) <= tolerance*math.Max(1, rounded) {
+		return int(rounded)
+	}
+	return int(math.Ceil(quotient))
+}
+
+// Repeat returns a Slice with value repeated n times.
+// If n is zero or negative, an empty Slice is returned.
+func Repeat[T any](value T, n int) Slice[T] {
+	return Times(n, func(int) T {
+		return value
+	})
+}
+
+// Times calls f n times with the indexes from 0 to n-1 and returns a Slice with the results.
+// If n is zero or negative, an empty Slice is returned and f is never called.
+func Times[T any](n int, f func(i int) T) Slice[T] {
+	result := make(Slice[T], max(n, 0))
+	for i := range result {
+		result[i] = f(i)
+	}
+	return result
+}
+
+// RepeatSlice returns a new Slice with the elements of s repeated n times, keeping their order.
+// If n is zero or negative, an empty Slice is returned. The elements are copied shallowly.
+func RepeatSlice[T any, S ~[]T](s S, n int) Slice[T] {
+	result := make(Slice[T], 0, len(s)*max(n, 0))
+	for i := 0; i < n; i++ {
+		result = append(result, s...)
+	}
+	return result
+}
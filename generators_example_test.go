@@ -0,0 +1,41 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleRange() {
+	fmt.Println(godash.Range(0, 10, 2))
+	fmt.Println(godash.Range(5, 0, -1))
+	fmt.Println(godash.Range(0, 0.3, 0.1))
+
+	isOdd := func(i int) bool { return i%2 == 1 }
+	fmt.Println(godash.Range(0, 10, 1).Filter(isOdd))
+
+	// Output:
+	// [0 2 4 6 8]
+	// [5 4 3 2 1]
+	// [0 0.1 0.2]
+	// [1 3 5 7 9]
+}
+
+func ExampleRepeat() {
+	fmt.Println(godash.Repeat("ab", 3))
+	// Output:
+	// [ab ab ab]
+}
+
+func ExampleTimes() {
+	fmt.Println(godash.Times(4, func(i int) string {
+		return fmt.Sprintf("item-%d", i)
+	}))
+	// Output:
+	// [item-0 item-1 item-2 item-3]
+}
+
+func ExampleRepeatSlice() {
+	fmt.Println(godash.RepeatSlice([]int{1, 2}, 3))
+	// Output:
+	// [1 2 1 2 1 2]
+}
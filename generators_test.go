@@ -0,0 +1,200 @@
+package godash
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("integer ranges", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			start, end, step int
+			want             Slice[int]
+		}{{
+			name:  "ascending range",
+			start: 0, end: 5, step: 1,
+			want: Slice[int]{0, 1, 2, 3, 4},
+		}, {
+			name:  "step not dividing the range",
+			start: 0, end: 10, step: 3,
+			want: Slice[int]{0, 3, 6, 9},
+		}, {
+			name:  "descending range",
+			start: 10, end: 0, step: -3,
+			want: Slice[int]{10, 7, 4, 1},
+		}, {
+			name:  "negative numbers",
+			start: -3, end: 3, step: 2,
+			want: Slice[int]{-3, -1, 1},
+		}, {
+			name:  "empty range",
+			start: 3, end: 3, step: 1,
+			want: Slice[int]{},
+		}, {
+			name:  "step moving away from end",
+			start: 0, end: 10, step: -1,
+			want: Slice[int]{},
+		}, {
+			name:  "zero step",
+			start: 0, end: 10, step: 0,
+			want: Slice[int]{},
+		}}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := Range(tt.start, tt.end, tt.step); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Range(%d, %d, %d) = %v, want %v", tt.start, tt.end, tt.step, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("float ranges", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			start, end, step float64
+			want             Slice[float64]
+		}{{
+			name:  "end value affected by rounding errors",
+			start: 0, end: 0.3, step: 0.1,
+			want: Slice[float64]{0, 0.1, 0.2},
+		}, {
+			name:  "values don't drift",
+			start: 0, end: 1, step: 0.1,
+			want: Slice[float64]{0, 0.1, 0.2, 0.30000000000000004, 0.4, 0.5, 0.6000000000000001, 0.7000000000000001, 0.8, 0.9},
+		}, {
+			name:  "descending range",
+			start: 1, end: 0, step: -0.25,
+			want: Slice[float64]{1, 0.75, 0.5, 0.25},
+		}, {
+			name:  "step not dividing the range",
+			start: 0, end: 1, step: 0.4,
+			want: Slice[float64]{0, 0.4, 0.8},
+		}}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := Range(tt.start, tt.end, tt.step); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Range(%v, %v, %v) = %v, want %v", tt.start, tt.end, tt.step, got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("float32 range", func(t *testing.T) {
+		if got := Range[float32](0, 0.3, 0.1); len(got) != 3 {
+			t.Errorf("Range[float32](0, 0.3, 0.1) = %v, want 3 elements", got)
+		}
+	})
+
+	t.Run("range wider than half of the type", func(t *testing.T) {
+		got := Range[int8](-100, 100, 50)
+		want := Slice[int8]{-100, -50, 0, 50}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Range[int8](-100, 100, 50) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unsigned range", func(t *testing.T) {
+		if got, want := Range[uint](1, 4, 1), (Slice[uint]{1, 2, 3}); !reflect.DeepEqual(got, want) {
+			t.Errorf("Range[uint](1, 4, 1) = %v, want %v", got, want)
+		}
+		if got := Range[uint](4, 1, 1); len(got) != 0 {
+			t.Errorf("Range[uint](4, 1, 1) = %v, want empty", got)
+		}
+	})
+
+	t.Run("non-finite arguments", func(t *testing.T) {
+		inf, nan := math.Inf(1), math.NaN()
+		tests := [][3]float64{
+			{0, inf, 1}, {0, -inf, -1}, {-inf, 0, 1}, {inf, 0, -1},
+			{0, 10, inf}, {0, 10, nan}, {nan, 10, 1}, {0, nan, 1},
+		}
+		for _, tt := range tests {
+			if got := Range(tt[0], tt[1], tt[2]); got == nil || len(got) != 0 {
+				t.Errorf("Range(%g, %g, %g) = %v, want an empty Slice", tt[0], tt[1], tt[2], got)
+			}
+		}
+		if got := Range(float32(0), float32(inf), 1); len(got) != 0 {
+			t.Errorf("Range[float32](0, +Inf, 1) = %v, want an empty Slice", got)
+		}
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		n     int
+		want  Slice[string]
+	}{
+		{name: "repeat value", value: "a", n: 3, want: Slice[string]{"a", "a", "a"}},
+		{name: "zero times", value: "a", n: 0, want: Slice[string]{}},
+		{name: "negative times", value: "a", n: -1, want: Slice[string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Repeat(tt.value, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Repeat(%q, %d) = %v, want %v", tt.value, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimes(t *testing.T) {
+	square := func(i int) int { return i * i }
+
+	tests := []struct {
+		name string
+		n    int
+		want Slice[int]
+	}{
+		{name: "call n times", n: 4, want: Slice[int]{0, 1, 4, 9}},
+		{name: "zero times", n: 0, want: Slice[int]{}},
+		{name: "negative times", n: -2, want: Slice[int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Times(tt.n, square); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Times(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepeatSlice(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+		want  Slice[int]
+	}{
+		{name: "repeat slice", input: []int{1, 2}, n: 3, want: Slice[int]{1, 2, 1, 2, 1, 2}},
+		{name: "repeat once", input: []int{1, 2}, n: 1, want: Slice[int]{1, 2}},
+		{name: "zero times", input: []int{1, 2}, n: 0, want: Slice[int]{}},
+		{name: "negative times", input: []int{1, 2}, n: -1, want: Slice[int]{}},
+		{name: "empty slice", input: []int{}, n: 3, want: Slice[int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RepeatSlice(tt.input, tt.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RepeatSlice(%v, %d) = %v, want %v", tt.input, tt.n, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("result doesn't share the input backing array", func(t *testing.T) {
+		input := []int{1, 2}
+		got := RepeatSlice(input, 1)
+		got[0] = 99
+		if input[0] != 1 {
+			t.Errorf("RepeatSlice() modified the input: %v", input)
+		}
+	})
+}
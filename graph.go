@@ -0,0 +1,390 @@
+package godash
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrCycle is returned when a graph that must be acyclic has a cycle.
+var ErrCycle = errors.New("graph has a cycle")
+
+// CycleError reports a cycle found in a [Graph]. Its Err is [ErrCycle].
+type CycleError[N comparable] struct {
+	// Cycle holds the nodes of the cycle in the order of its edges, starting and ending with the same node.
+	Cycle []N
+	Err   error
+}
+
+// Error describes the nodes of the cycle.
+func (e *CycleError[N]) Error() string {
+	nodes := make([]string, len(e.Cycle))
+	for i, node := range e.Cycle {
+		nodes[i] = fmt.Sprint(node)
+	}
+	return fmt.Sprintf("%v: %s", e.Err, strings.Join(nodes, " -> "))
+}
+
+// Unwrap returns [ErrCycle].
+func (e *CycleError[N]) Unwrap() error {
+	return e.Err
+}
+
+// GraphOptions configures a [Graph].
+type GraphOptions struct {
+	// Undirected makes every edge go both ways.
+	Undirected bool
+}
+
+// Graph is a directed or undirected graph, where the neighbors of every node are kept in a [Set]. Edges have a
+// weight, which is 1 unless they are added with [Graph.AddWeightedEdge].
+//
+// Nodes are visited in the order they were added to the graph, so traversals are deterministic. The zero value
+// is an empty directed graph. A Graph isn't safe for concurrent use.
+type Graph[N comparable] struct {
+	undirected bool
+	nodes      []N
+	index      map[N]int // of every node, in nodes
+	adjacency  map[N]Set[N]
+	weights    map[graphEdge[N]]float64
+}
+
+// graphEdge is an edge of a [Graph]. The edges of an undirected graph are stored in both directions.
+type graphEdge[N comparable] struct {
+	from, to N
+}
+
+// NewGraph creates an empty Graph configured by the options.
+func NewGraph[N comparable](options GraphOptions) *Graph[N] {
+	return &Graph[N]{undirected: options.Undirected}
+}
+
+// AddNode adds the node to the graph, if it isn't there yet.
+func (g *Graph[N]) AddNode(node N) {
+	if g.HasNode(node) {
+		return
+	}
+	if g.index == nil {
+		g.index, g.adjacency, g.weights = map[N]int{}, map[N]Set[N]{}, map[graphEdge[N]]float64{}
+	}
+	g.index[node] = len(g.nodes)
+	g.nodes = append(g.nodes, node)
+	g.adjacency[node] = NewSet[N]()
+}
+
+// AddEdge adds an edge of weight 1 from a node to another one, adding the nodes that aren't in the graph yet.
+func (g *Graph[N]) AddEdge(from, to N) {
+	g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge with the weight from a node to another one, adding the nodes that aren't in the
+// graph yet. If the edge already exists, its weight is replaced.
+func (g *Graph[N]) AddWeightedEdge(from, to N, weight float64) {
+	g.AddNode(from)
+	g.AddNode(to)
+	neighbors := g.adjacency[from]
+	neighbors.Add(to)
+	g.weights[graphEdge[N]{from, to}] = weight
+	if g.undirected {
+		neighbors = g.adjacency[to]
+		neighbors.Add(from)
+		g.weights[graphEdge[N]{to, from}] = weight
+	}
+}
+
+// HasNode checks whether the node is in the graph.
+func (g *Graph[N]) HasNode(node N) bool {
+	_, ok := g.index[node]
+	return ok
+}
+
+// HasEdge checks whether the graph has an edge from a node to another one.
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	_, ok := g.weights[graphEdge[N]{from, to}]
+	return ok
+}
+
+// Weight returns the weight of the edge from a node to another one.
+// If there is no such edge, it returns `0` and `false`.
+func (g *Graph[N]) Weight(from, to N) (float64, bool) {
+	weight, ok := g.weights[graphEdge[N]{from, to}]
+	return weight, ok
+}
+
+// Nodes returns the nodes of the graph in a new Slice, in the order they were added.
+func (g *Graph[N]) Nodes() Slice[N] {
+	return slices.Clone(Slice[N](g.nodes))
+}
+
+// Neighbors returns the nodes the edges from the node lead to, in a new Set.
+func (g *Graph[N]) Neighbors(node N) Set[N] {
+	neighbors := NewSet[N]()
+	for neighbor := range g.adjacency[node] {
+		neighbors.Add(neighbor)
+	}
+	return neighbors
+}
+
+// BFS returns a sequence of the nodes reachable from the start node, including itself, in breadth-first order.
+// The sequence is empty if the start node isn't in the graph.
+func (g *Graph[N]) BFS(start N) Seq[N] {
+	return func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := NewSet(start)
+		queue := []N{start}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			if !yield(node) {
+				return
+			}
+			for _, neighbor := range g.neighbors(node) {
+				if !visited.Has(neighbor) {
+					visited.Add(neighbor)
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+}
+
+// DFS returns a sequence of the nodes reachable from the start node, including itself, in depth-first order,
+// yielding every node before its descendants. The sequence is empty if the start node isn't in the graph.
+func (g *Graph[N]) DFS(start N) Seq[N] {
+	return func(yield func(N) bool) {
+		if !g.HasNode(start) {
+			return
+		}
+		visited := NewSet[N]()
+		stack := []N{start}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited.Has(node) {
+				continue
+			}
+			visited.Add(node)
+			if !yield(node) {
+				return
+			}
+			neighbors := g.neighbors(node)
+			for i := len(neighbors) - 1; i >= 0; i-- {
+				if !visited.Has(neighbors[i]) {
+					stack = append(stack, neighbors[i])
+				}
+			}
+		}
+	}
+}
+
+// TopologicalSort returns the nodes of the graph ordered so every edge goes from a node to a later one.
+// If the graph has a cycle, it returns a *[CycleError] naming the nodes of one of its cycles. An undirected
+// graph with an edge always has a cycle.
+func (g *Graph[N]) TopologicalSort() (Slice[N], error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[N]int, len(g.nodes))
+	var path []N // the nodes being visited, from the outermost one
+	sorted := make(Slice[N], 0, len(g.nodes))
+
+	var visit func(node N) error
+	visit = func(node N) error {
+		states[node] = visiting
+		path = append(path, node)
+		for _, neighbor := range g.neighbors(node) {
+			switch states[neighbor] {
+			case visiting:
+				start := slices.Index(path, neighbor)
+				cycle := append(slices.Clone(path[start:]), neighbor)
+				return &CycleError[N]{Cycle: cycle, Err: ErrCycle}
+			case unvisited:
+				if err := visit(neighbor); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		states[node] = visited
+		sorted = append(sorted, node)
+		return nil
+	}
+
+	for _, node := range g.nodes {
+		if states[node] == unvisited {
+			if err := visit(node); err != nil {
+				return nil, err
+			}
+		}
+	}
+	slices.Reverse(sorted)
+	return sorted, nil
+}
+
+// StronglyConnectedComponents returns the strongly connected components of the graph, the largest groups of
+// nodes where every node can reach the others, using Tarjan's algorithm. A component comes before the
+// components it has edges from, so they are in reverse topological order. For an undirected graph, they are
+// its connected components.
+func (g *Graph[N]) StronglyConnectedComponents() []Set[N] {
+	indexes := make(map[N]int, len(g.nodes)) // in the order nodes are visited
+	lowLinks := make(map[N]int, len(g.nodes))
+	onStack := NewSet[N]()
+	var stack []N
+	var components []Set[N]
+
+	var connect func(node N)
+	connect = func(node N) {
+		indexes[node], lowLinks[node] = len(indexes), len(indexes)
+		stack = append(stack, node)
+		onStack.Add(node)
+
+		for _, neighbor := range g.neighbors(node) {
+			if _, seen := indexes[neighbor]; !seen {
+				connect(neighbor)
+				lowLinks[node] = min(lowLinks[node], lowLinks[neighbor])
+			} else if onStack.Has(neighbor) {
+				lowLinks[node] = min(lowLinks[node], indexes[neighbor])
+			}
+		}
+
+		if lowLinks[node] == indexes[node] {
+			component := NewSet[N]()
+			for {
+				member := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack.Delete(member)
+				component.Add(member)
+				if member == node {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for _, node := range g.nodes {
+		if _, seen := indexes[node]; !seen {
+			connect(node)
+		}
+	}
+	return components
+}
+
+// ShortestPath returns the path with the lowest total weight from a node to another one, including both of
+// them, and its total weight, using Dijkstra's algorithm. If the target can't be reached, it returns `false`.
+// It panics if it finds an edge with a negative weight.
+func (g *Graph[N]) ShortestPath(from, to N) (Slice[N], float64, bool) {
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return nil, 0, false
+	}
+	distances := map[N]float64{from: 0}
+	previous := map[N]N{}
+	done := NewSet[N]()
+	queue := &pathQueue[N]{{node: from}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(pathItem[N])
+		if done.Has(item.node) {
+			continue
+		}
+		if item.node == to {
+			break
+		}
+		done.Add(item.node)
+
+		for _, neighbor := range g.neighbors(item.node) {
+			weight := g.weights[graphEdge[N]{item.node, neighbor}]
+			if weight < 0 {
+				panic("godash: ShortestPath with a negative edge weight")
+			}
+			distance := item.distance + weight
+			if current, ok := distances[neighbor]; !ok || distance < current {
+				distances[neighbor], previous[neighbor] = distance, item.node
+				heap.Push(queue, pathItem[N]{node: neighbor, distance: distance})
+			}
+		}
+	}
+
+	distance, ok := distances[to]
+	if !ok {
+		return nil, 0, false
+	}
+	path := Slice[N]{to}
+	for node := to; node != from; {
+		node = previous[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+	return path, distance, true
+}
+
+// DOT returns the graph in the DOT language of Graphviz, to visualize it. Node names are formatted with
+// [fmt.Sprint], and edges with a weight other than 1 are labeled with it.
+func (g *Graph[N]) DOT() string {
+	var builder strings.Builder
+	kind, arrow := "digraph", "->"
+	if g.undirected {
+		kind, arrow = "graph", "--"
+	}
+	builder.WriteString(kind + " {\n")
+	for _, node := range g.nodes {
+		builder.WriteString("\t" + dotID(node) + ";\n")
+	}
+	for _, node := range g.nodes {
+		for _, neighbor := range g.neighbors(node) {
+			if g.undirected && g.index[neighbor] < g.index[node] {
+				continue // already written from the neighbor
+			}
+			builder.WriteString(fmt.Sprintf("\t%s %s %s", dotID(node), arrow, dotID(neighbor)))
+			if weight := g.weights[graphEdge[N]{node, neighbor}]; weight != 1 {
+				builder.WriteString(" [label=" + strconv.Quote(strconv.FormatFloat(weight, 'g', -1, 64)) + "]")
+			}
+			builder.WriteString(";\n")
+		}
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// neighbors returns the neighbors of the node in the order they were added to the graph.
+func (g *Graph[N]) neighbors(node N) []N {
+	adjacent := g.adjacency[node]
+	neighbors := adjacent.Values()
+	slices.SortFunc(neighbors, func(a, b N) int {
+		return g.index[a] - g.index[b]
+	})
+	return neighbors
+}
+
+// dotID formats the node as a quoted DOT identifier.
+func dotID(node any) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(fmt.Sprint(node)) + `"`
+}
+
+// pathItem is a node reached by [Graph.ShortestPath], with the total weight of the path to it.
+type pathItem[N comparable] struct {
+	node     N
+	distance float64
+}
+
+// pathQueue is a [heap.Interface] of the nodes to visit, with the closest one first.
+type pathQueue[N comparable] []pathItem[N]
+
+func (q pathQueue[N]) Len() int           { return len(q) }
+func (q pathQueue[N]) Less(i, j int) bool { return q[i].distance < q[j].distance }
+func (q pathQueue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue[N]) Push(x any)        { *q = append(*q, x.(pathItem[N])) }
+
+func (q *pathQueue[N]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
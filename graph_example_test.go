@@ -0,0 +1,32 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleGraph_TopologicalSort() {
+	services := godash.NewGraph[string](godash.GraphOptions{})
+	services.AddEdge("web", "api")
+	services.AddEdge("api", "db")
+	services.AddEdge("api", "cache")
+	fmt.Println(services.TopologicalSort())
+
+	services.AddEdge("db", "web")
+	fmt.Println(services.TopologicalSort())
+	// Output:
+	// [web api cache db] <nil>
+	// [] graph has a cycle: web -> api -> db -> web
+}
+
+func ExampleGraph_ShortestPath() {
+	roads := godash.NewGraph[string](godash.GraphOptions{Undirected: true})
+	roads.AddWeightedEdge("Lisbon", "Madrid", 625)
+	roads.AddWeightedEdge("Madrid", "Paris", 1270)
+	roads.AddWeightedEdge("Lisbon", "Porto", 315)
+	roads.AddWeightedEdge("Porto", "Paris", 1650)
+
+	fmt.Println(roads.ShortestPath("Lisbon", "Paris"))
+	// Output:
+	// [Lisbon Madrid Paris] 1895 true
+}
@@ -0,0 +1,220 @@
+package godash
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	var g Graph[string]
+	g.AddEdge("a", "b")
+	g.AddWeightedEdge("a", "c", 2.5)
+	g.AddNode("d")
+	g.AddNode("a")
+
+	if got := g.Nodes(); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Nodes() = %v, want [a b c d]", got)
+	}
+	if got := g.Neighbors("a"); !maps.Equal(got, NewSet("b", "c")) {
+		t.Errorf("Neighbors(a) = %v, want set{b, c}", got)
+	}
+	if got := g.Neighbors("x"); got.Size() != 0 {
+		t.Errorf("Neighbors(x) = %v, want an empty set", got)
+	}
+	if !g.HasEdge("a", "b") || g.HasEdge("b", "a") || !g.HasNode("d") || g.HasNode("x") {
+		t.Error("HasEdge() and HasNode() don't match the graph")
+	}
+	if w, ok := g.Weight("a", "c"); !ok || w != 2.5 {
+		t.Errorf("Weight(a, c) = %g, %t, want 2.5, true", w, ok)
+	}
+
+	u := NewGraph[int](GraphOptions{Undirected: true})
+	u.AddWeightedEdge(1, 2, 3)
+	if w, ok := u.Weight(2, 1); !ok || w != 3 || !u.HasEdge(1, 2) {
+		t.Errorf("Weight(2, 1) = %g, %t, want 3, true", w, ok)
+	}
+}
+
+func TestGraph_Traversals(t *testing.T) {
+	g := NewGraph[int](GraphOptions{})
+	for _, edge := range [][2]int{{1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}, {2, 6}, {5, 1}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	g.AddNode(7)
+
+	if got := Collect(g.BFS(1)); !slices.Equal(got, []int{1, 2, 3, 4, 6, 5}) {
+		t.Errorf("BFS(1) = %v, want [1 2 3 4 6 5]", got)
+	}
+	if got := Collect(g.DFS(1)); !slices.Equal(got, []int{1, 2, 4, 5, 6, 3}) {
+		t.Errorf("DFS(1) = %v, want [1 2 4 5 6 3]", got)
+	}
+	if got := Collect(g.DFS(7)); !slices.Equal(got, []int{7}) {
+		t.Errorf("DFS(7) = %v, want [7]", got)
+	}
+	if got := Collect(g.BFS(8)); len(got) != 0 {
+		t.Errorf("BFS(8) = %v, want no nodes", got)
+	}
+
+	var firstTwo []int
+	g.DFS(1)(func(node int) bool {
+		firstTwo = append(firstTwo, node)
+		return len(firstTwo) < 2
+	})
+	if !slices.Equal(firstTwo, []int{1, 2}) {
+		t.Errorf("DFS(1) yielded %v after being stopped, want [1 2]", firstTwo)
+	}
+}
+
+func TestGraph_TopologicalSort(t *testing.T) {
+	g := NewGraph[string](GraphOptions{})
+	for _, edge := range [][2]string{{"api", "db"}, {"api", "cache"}, {"web", "api"}, {"cache", "db"}, {"worker", "db"}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	sorted, err := g.TopologicalSort()
+	if err != nil || !slices.Equal(sorted, []string{"worker", "web", "api", "cache", "db"}) {
+		t.Errorf("TopologicalSort() = %v, %v, want [worker web api cache db]", sorted, err)
+	}
+
+	g.AddEdge("db", "web")
+	_, err = g.TopologicalSort()
+	var cycleErr *CycleError[string]
+	if !errors.Is(err, ErrCycle) || !errors.As(err, &cycleErr) {
+		t.Fatalf("TopologicalSort() error = %v, want a CycleError", err)
+	}
+	if want := []string{"api", "db", "web", "api"}; !slices.Equal(cycleErr.Cycle, want) {
+		t.Errorf("Cycle = %v, want %v", cycleErr.Cycle, want)
+	}
+	if got, want := err.Error(), "graph has a cycle: api -> db -> web -> api"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	loop := NewGraph[int](GraphOptions{})
+	loop.AddEdge(1, 1)
+	var loopErr *CycleError[int]
+	if _, err := loop.TopologicalSort(); !errors.As(err, &loopErr) || !slices.Equal(loopErr.Cycle, []int{1, 1}) {
+		t.Errorf("TopologicalSort() of a self-loop error = %v, want a CycleError", err)
+	}
+}
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	g := NewGraph[int](GraphOptions{})
+	for _, edge := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 4}, {6, 5}, {6, 6}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+	want := []Set[int]{NewSet(4, 5), NewSet(1, 2, 3), NewSet(6)}
+	got := g.StronglyConnectedComponents()
+	if !slices.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("StronglyConnectedComponents() = %v, want %v", got, want)
+	}
+
+	u := NewGraph[int](GraphOptions{Undirected: true})
+	u.AddEdge(1, 2)
+	u.AddEdge(3, 2)
+	u.AddNode(4)
+	want = []Set[int]{NewSet(1, 2, 3), NewSet(4)}
+	if got := u.StronglyConnectedComponents(); !slices.EqualFunc(got, want, maps.Equal) {
+		t.Errorf("StronglyConnectedComponents() = %v, want %v", got, want)
+	}
+}
+
+func TestGraph_ShortestPath(t *testing.T) {
+	g := NewGraph[string](GraphOptions{})
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("c", "b", 2)
+	g.AddWeightedEdge("b", "d", 1)
+	g.AddWeightedEdge("c", "d", 5)
+	g.AddNode("e")
+
+	tests := []struct {
+		from, to string
+		path     []string
+		distance float64
+		ok       bool
+	}{
+		{"a", "d", []string{"a", "c", "b", "d"}, 4, true},
+		{"a", "a", []string{"a"}, 0, true},
+		{"d", "a", nil, 0, false},
+		{"a", "e", nil, 0, false},
+		{"a", "x", nil, 0, false},
+	}
+	for _, tt := range tests {
+		path, distance, ok := g.ShortestPath(tt.from, tt.to)
+		if !slices.Equal(path, tt.path) || distance != tt.distance || ok != tt.ok {
+			t.Errorf("ShortestPath(%s, %s) = %v, %g, %t, want %v, %g, %t",
+				tt.from, tt.to, path, distance, ok, tt.path, tt.distance, tt.ok)
+		}
+	}
+
+	g.AddWeightedEdge("a", "b", -1)
+	defer func() {
+		if recover() == nil {
+			t.Error("ShortestPath() with a negative weight didn't panic")
+		}
+	}()
+	g.ShortestPath("a", "d")
+}
+
+func TestGraph_ShortestPathRandom(t *testing.T) {
+	// Dijkstra's distances are checked against the Bellman-Ford relaxation of every edge.
+	r := rand.New(rand.NewPCG(9, 10))
+	g := NewGraph[int](GraphOptions{})
+	for i := 0; i < 300; i++ {
+		g.AddWeightedEdge(r.IntN(50), r.IntN(50), float64(r.IntN(20)))
+	}
+
+	for _, from := range g.Nodes() {
+		distances := map[int]float64{from: 0}
+		for changed := true; changed; {
+			changed = false
+			for _, node := range g.Nodes() {
+				d, ok := distances[node]
+				if !ok {
+					continue
+				}
+				for next := range g.Neighbors(node) {
+					w, _ := g.Weight(node, next)
+					if current, ok := distances[next]; !ok || d+w < current {
+						distances[next], changed = d+w, true
+					}
+				}
+			}
+		}
+
+		for _, to := range g.Nodes() {
+			path, distance, ok := g.ShortestPath(from, to)
+			want, reachable := distances[to]
+			if ok != reachable || distance != want {
+				t.Fatalf("ShortestPath(%d, %d) = %g, %t, want %g, %t", from, to, distance, ok, want, reachable)
+			}
+			total := 0.0
+			for i := 1; i < len(path); i++ {
+				w, _ := g.Weight(path[i-1], path[i])
+				total += w
+			}
+			if ok && (path[0] != from || path[len(path)-1] != to || total != distance) {
+				t.Fatalf("ShortestPath(%d, %d) returned the path %v of weight %g", from, to, path, total)
+			}
+		}
+	}
+}
+
+func TestGraph_DOT(t *testing.T) {
+	g := NewGraph[string](GraphOptions{})
+	g.AddEdge("a", `say "hi"`)
+	g.AddWeightedEdge("a", "b", 0.5)
+	want := "digraph {\n\t\"a\";\n\t\"say \\\"hi\\\"\";\n\t\"b\";\n" +
+		"\t\"a\" -> \"say \\\"hi\\\"\";\n\t\"a\" -> \"b\" [label=\"0.5\"];\n}\n"
+	if got := g.DOT(); got != want {
+		t.Errorf("DOT() = %q, want %q", got, want)
+	}
+
+	u := NewGraph[int](GraphOptions{Undirected: true})
+	u.AddEdge(2, 1)
+	if got, want := u.DOT(), "graph {\n\t\"2\";\n\t\"1\";\n\t\"2\" -- \"1\";\n}\n"; got != want {
+		t.Errorf("DOT() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+package godash
+
+// Pair holds two values of possibly different types, such as the matching rows of a join.
+type Pair[L any, R any] struct {
+	Left  L
+	Right R
+}
+
+// NewPair creates a Pair with the given values.
+func NewPair[L any, R any](left L, right R) Pair[L, R] {
+	return Pair[L, R]{Left: left, Right: right}
+}
+
+// The joins below match the rows of two slices by the keys returned by a key function for each side.
+// They index the right slice in a hash map, so they run in O(n+m) plus the size of the output, instead of
+// the O(n·m) of nested searches. The output follows the order of the left slice, and when a key appears in
+// several rows, every combination of matching rows is produced, following the order of the right slice.
+
+// indexByKey returns the positions of the elements of s grouped by their keys.
+func indexByKey[T any, K comparable, S ~[]T](s S, key MustMapper[T, K]) map[K][]int {
+	index := make(map[K][]int)
+	for i, v := range s {
+		k := key(v)
+		index[k] = append(index[k], i)
+	}
+	return index
+}
+
+// InnerJoin returns a Pair for every combination of rows of left and right with equal keys.
+// Rows without a match on the other side are left out.
+func InnerJoin[L any, R any, K comparable, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K],
+) Slice[Pair[L, R]] {
+	return InnerJoinFunc(left, right, leftKey, rightKey, NewPair[L, R])
+}
+
+// InnerJoinFunc behaves like [InnerJoin], except every combination of matching rows is passed to the combine
+// function, and its results are returned instead of pairs.
+func InnerJoinFunc[L any, R any, K comparable, O any, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K], combine func(L, R) O,
+) Slice[O] {
+	index := indexByKey(right, rightKey)
+	result := make(Slice[O], 0)
+	for _, l := range left {
+		for _, i := range index[leftKey(l)] {
+			result = append(result, combine(l, right[i]))
+		}
+	}
+	return result
+}
+
+// LeftJoin behaves like [InnerJoin], except rows of left without a match are kept, paired with a nil right side.
+// The right side of the pairs points to the elements of the right slice, which are not copied.
+func LeftJoin[L any, R any, K comparable, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K],
+) Slice[Pair[L, *R]] {
+	return LeftJoinFunc(left, right, leftKey, rightKey, NewPair[L, *R])
+}
+
+// LeftJoinFunc behaves like [LeftJoin], except every combination of matching rows, and every row of left
+// without a match along with nil, is passed to the combine function, and its results are returned instead of pairs.
+func LeftJoinFunc[L any, R any, K comparable, O any, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K], combine func(L, *R) O,
+) Slice[O] {
+	index := indexByKey(right, rightKey)
+	result := make(Slice[O], 0)
+	for _, l := range left {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			result = append(result, combine(l, nil))
+		}
+		for _, i := range matches {
+			result = append(result, combine(l, &right[i]))
+		}
+	}
+	return result
+}
+
+// FullOuterJoin behaves like [LeftJoin], except rows of right without a match are also kept, paired with a nil
+// left side. They come after the rows following the order of left, in the order they appear in right.
+// Both sides of the pairs point to the elements of the input slices, which are not copied.
+func FullOuterJoin[L any, R any, K comparable, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K],
+) Slice[Pair[*L, *R]] {
+	return FullOuterJoinFunc(left, right, leftKey, rightKey, NewPair[*L, *R])
+}
+
+// FullOuterJoinFunc behaves like [FullOuterJoin], except every combination of matching rows, and every row
+// without a match along with nil, is passed to the combine function, and its results are returned instead of pairs.
+func FullOuterJoinFunc[L any, R any, K comparable, O any, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K], combine func(*L, *R) O,
+) Slice[O] {
+	index := indexByKey(right, rightKey)
+	matchedRight := make([]bool, len(right))
+	result := make(Slice[O], 0)
+	for i := range left {
+		matches := index[leftKey(left[i])]
+		if len(matches) == 0 {
+			result = append(result, combine(&left[i], nil))
+		}
+		for _, j := range matches {
+			matchedRight[j] = true
+			result = append(result, combine(&left[i], &right[j]))
+		}
+	}
+
+	for j := range right {
+		if !matchedRight[j] {
+			result = append(result, combine(nil, &right[j]))
+		}
+	}
+	return result
+}
+
+// SemiJoin returns the rows of left that have at least one match in right. Each row is returned only once,
+// no matter how many matches it has.
+func SemiJoin[L any, R any, K comparable, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K],
+) Slice[L] {
+	rightKeys := keySet(right, rightKey)
+	return Filter(left, func(l L) bool {
+		return rightKeys.Has(leftKey(l))
+	})
+}
+
+// SemiJoinBy behaves like [SemiJoin], except every row of left with a match is passed to the combine function
+// once, along with all its matching rows of right in their order, and its results are returned instead of the rows.
+func SemiJoinBy[L any, R any, K comparable, O any, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K], combine func(L, []R) O,
+) Slice[O] {
+	index := indexByKey(right, rightKey)
+	result := make(Slice[O], 0)
+	for _, l := range left {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			continue
+		}
+		rows := make([]R, len(matches))
+		for i, j := range matches {
+			rows[i] = right[j]
+		}
+		result = append(result, combine(l, rows))
+	}
+	return result
+}
+
+// AntiJoin returns the rows of left that have no match in right.
+func AntiJoin[L any, R any, K comparable, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K],
+) Slice[L] {
+	rightKeys := keySet(right, rightKey)
+	return Filter(left, func(l L) bool {
+		return !rightKeys.Has(leftKey(l))
+	})
+}
+
+// AntiJoinBy behaves like [AntiJoin], except every row of left without a match is passed to the combine function,
+// and its results are returned instead of the rows.
+func AntiJoinBy[L any, R any, K comparable, O any, SL ~[]L, SR ~[]R](
+	left SL, right SR, leftKey MustMapper[L, K], rightKey MustMapper[R, K], combine func(L) O,
+) Slice[O] {
+	return MustMap(AntiJoin(left, right, leftKey, rightKey), combine)
+}
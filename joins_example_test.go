@@ -0,0 +1,30 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleInnerJoin() {
+	type customer struct {
+		id   int
+		name string
+	}
+	type order struct {
+		id         string
+		customerID int
+	}
+	customers := []customer{{1, "ana"}, {2, "bob"}}
+	orders := []order{{"o1", 2}, {"o2", 1}, {"o3", 2}}
+
+	pairs := godash.InnerJoin(customers, orders,
+		func(c customer) int { return c.id },
+		func(o order) int { return o.customerID })
+	for _, p := range pairs {
+		fmt.Println(p.Left.name, p.Right.id)
+	}
+	// Output:
+	// ana o2
+	// bob o1
+	// bob o3
+}
@@ -0,0 +1,148 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type joinCustomer struct {
+	id   int
+	name string
+}
+
+type joinOrder struct {
+	id         string
+	customerID int
+}
+
+var (
+	joinCustomers = []joinCustomer{{1, "ana"}, {2, "bob"}, {3, "carl"}, {2, "bob-duplicate"}}
+	joinOrders    = []joinOrder{{"o1", 2}, {"o2", 1}, {"o3", 9}, {"o4", 2}}
+
+	customerID      = func(c joinCustomer) int { return c.id }
+	orderCustomerID = func(o joinOrder) int { return o.customerID }
+)
+
+func TestInnerJoin(t *testing.T) {
+	got := InnerJoin(joinCustomers, joinOrders, customerID, orderCustomerID)
+	want := Slice[Pair[joinCustomer, joinOrder]]{
+		{joinCustomers[0], joinOrders[1]},
+		{joinCustomers[1], joinOrders[0]},
+		{joinCustomers[1], joinOrders[3]},
+		{joinCustomers[3], joinOrders[0]},
+		{joinCustomers[3], joinOrders[3]},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InnerJoin() = %v, want %v", got, want)
+	}
+
+	t.Run("combine function", func(t *testing.T) {
+		got := InnerJoinFunc(joinCustomers, joinOrders, customerID, orderCustomerID,
+			func(c joinCustomer, o joinOrder) string { return c.name + ":" + o.id })
+		want := Slice[string]{"ana:o2", "bob:o1", "bob:o4", "bob-duplicate:o1", "bob-duplicate:o4"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("InnerJoinFunc() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		if got := InnerJoin([]joinCustomer{}, joinOrders, customerID, orderCustomerID); len(got) != 0 {
+			t.Errorf("InnerJoin() = %v, want empty", got)
+		}
+		if got := InnerJoin(joinCustomers, []joinOrder(nil), customerID, orderCustomerID); len(got) != 0 {
+			t.Errorf("InnerJoin() = %v, want empty", got)
+		}
+	})
+}
+
+func TestLeftJoin(t *testing.T) {
+	got := LeftJoin(joinCustomers, joinOrders, customerID, orderCustomerID)
+	describe := func(p Pair[joinCustomer, *joinOrder]) string {
+		if p.Right == nil {
+			return p.Left.name + ":-"
+		}
+		return p.Left.name + ":" + p.Right.id
+	}
+
+	want := []string{"ana:o2", "bob:o1", "bob:o4", "carl:-", "bob-duplicate:o1", "bob-duplicate:o4"}
+	if gotDescriptions := MustMap(got, describe); !reflect.DeepEqual(gotDescriptions, want) {
+		t.Errorf("LeftJoin() = %v, want %v", gotDescriptions, want)
+	}
+
+	t.Run("right side points to the element of the right slice", func(t *testing.T) {
+		if got[0].Right != &joinOrders[1] {
+			t.Errorf("LeftJoin()[0].Right = %p, want %p", got[0].Right, &joinOrders[1])
+		}
+	})
+
+	t.Run("combine function", func(t *testing.T) {
+		got := LeftJoinFunc(joinCustomers[2:3], joinOrders, customerID, orderCustomerID,
+			func(c joinCustomer, o *joinOrder) bool { return o == nil })
+		if want := (Slice[bool]{true}); !reflect.DeepEqual(got, want) {
+			t.Errorf("LeftJoinFunc() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	got := FullOuterJoinFunc(joinCustomers, joinOrders, customerID, orderCustomerID,
+		func(c *joinCustomer, o *joinOrder) string {
+			left, right := "-", "-"
+			if c != nil {
+				left = c.name
+			}
+			if o != nil {
+				right = o.id
+			}
+			return left + ":" + right
+		})
+
+	want := Slice[string]{"ana:o2", "bob:o1", "bob:o4", "carl:-", "bob-duplicate:o1", "bob-duplicate:o4", "-:o3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FullOuterJoinFunc() = %v, want %v", got, want)
+	}
+
+	pairs := FullOuterJoin([]int{1, 2}, []string{"2", "3"}, func(i int) string { return fmt.Sprint(i) }, identity[string])
+	if len(pairs) != 3 || *pairs[0].Left != 1 || pairs[0].Right != nil || *pairs[1].Right != "2" ||
+		pairs[2].Left != nil || *pairs[2].Right != "3" {
+		t.Errorf("FullOuterJoin() = %v, want [1:nil 2:2 nil:3]", pairs)
+	}
+}
+
+func TestSemiJoinAndAntiJoin(t *testing.T) {
+	semi := SemiJoin(joinCustomers, joinOrders, customerID, orderCustomerID)
+	if want := (Slice[joinCustomer]{joinCustomers[0], joinCustomers[1], joinCustomers[3]}); !reflect.DeepEqual(semi, want) {
+		t.Errorf("SemiJoin() = %v, want %v", semi, want)
+	}
+
+	anti := AntiJoin(joinCustomers, joinOrders, customerID, orderCustomerID)
+	if want := (Slice[joinCustomer]{joinCustomers[2]}); !reflect.DeepEqual(anti, want) {
+		t.Errorf("AntiJoin() = %v, want %v", anti, want)
+	}
+
+	orphans := AntiJoin(joinOrders, joinCustomers, orderCustomerID, customerID)
+	if want := (Slice[joinOrder]{joinOrders[2]}); !reflect.DeepEqual(orphans, want) {
+		t.Errorf("AntiJoin() = %v, want %v", orphans, want)
+	}
+}
+
+func TestSemiJoinByAndAntiJoinBy(t *testing.T) {
+	orderIDs := func(c joinCustomer, orders []joinOrder) string {
+		return fmt.Sprint(c.name, MustMap(orders, func(o joinOrder) string { return o.id }))
+	}
+	semi := SemiJoinBy(joinCustomers, joinOrders, customerID, orderCustomerID, orderIDs)
+	if want := (Slice[string]{"ana[o2]", "bob[o1 o4]", "bob-duplicate[o1 o4]"}); !reflect.DeepEqual(semi, want) {
+		t.Errorf("SemiJoinBy() = %v, want %v", semi, want)
+	}
+
+	anti := AntiJoinBy(joinCustomers, joinOrders, customerID, orderCustomerID, func(c joinCustomer) string { return c.name })
+	if want := (Slice[string]{"carl"}); !reflect.DeepEqual(anti, want) {
+		t.Errorf("AntiJoinBy() = %v, want %v", anti, want)
+	}
+
+	empty := AntiJoinBy(joinCustomers, joinCustomers, customerID, customerID, func(c joinCustomer) string { return c.name })
+	if want := (Slice[string]{}); !reflect.DeepEqual(empty, want) {
+		t.Errorf("AntiJoinBy() = %#v, want %#v", empty, want)
+	}
+}
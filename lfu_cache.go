@@ -0,0 +1,202 @@
+package godash
+
+import (
+	"container/list"
+	"time"
+)
+
+// LFUCache is a [Cache] that evicts the least frequently used entry when a new one doesn't fit, breaking ties
+// by evicting the least recently used among them. Both Get and Put count as a use of an entry, and a new entry
+// starts with a single use. It isn't safe for concurrent use: see [NewSyncCache].
+//
+// Every operation runs in constant time, except Len and Keys, and Put of a new key in a full cache, which look
+// for expired entries.
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	options  CacheOptions[K, V]
+	clock    Clock
+	entries  map[K]*list.Element
+	buckets  *list.List // of *lfuBucket[K, V], by ascending number of uses
+}
+
+// lfuBucket holds the entries of an LFUCache with the same number of uses.
+type lfuBucket[K comparable, V any] struct {
+	uses    int
+	entries *list.List // of *lfuEntry[K, V], from the most to the least recently used
+}
+
+// lfuEntry is an entry of an LFUCache along with the bucket it belongs to.
+type lfuEntry[K comparable, V any] struct {
+	cacheEntry[K, V]
+	bucket *list.Element
+}
+
+// NewLFUCache creates an empty LFUCache holding at most capacity entries, configured by the options.
+// Zero or a negative capacity means the cache is unbounded, so entries are only removed when they expire.
+func NewLFUCache[K comparable, V any](capacity int, options CacheOptions[K, V]) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		capacity: capacity,
+		options:  options,
+		clock:    clockOrSystem(options.Clock),
+		entries:  make(map[K]*list.Element),
+		buckets:  list.New(),
+	}
+}
+
+// Get returns the value cached for the key and counts a use of it.
+// If the key isn't cached or its entry expired, it returns the zero value of type `V` and `false`.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	element, ok := c.live(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.use(element)
+	return element.Value.(*lfuEntry[K, V]).value, true
+}
+
+// Peek returns the value cached for the key without counting a use of it.
+// If the key isn't cached or its entry expired, it returns the zero value of type `V` and `false`.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	element, ok := c.entries[key]
+	if !ok || element.Value.(*lfuEntry[K, V]).expired(c.clock.Now()) {
+		var zero V
+		return zero, false
+	}
+	return element.Value.(*lfuEntry[K, V]).value, true
+}
+
+// Put caches the value for the key with the default TTL of the cache and counts a use of it.
+// If the key is new and the cache is full, the expired entries are evicted, or the least frequently used entry
+// if none expired.
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.options.TTL)
+}
+
+// PutWithTTL behaves like [LFUCache.Put], except the entry expires after ttl.
+// Zero or a negative ttl means the entry never expires.
+func (c *LFUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	expiresAt := expiration(c.clock, ttl)
+	if element, ok := c.live(key); ok {
+		entry := element.Value.(*lfuEntry[K, V])
+		entry.value, entry.expiresAt = value, expiresAt
+		c.use(element)
+		return
+	}
+
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		// Expired entries may be in any bucket, and they make room first.
+		c.removeExpired()
+	}
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		least := c.buckets.Front().Value.(*lfuBucket[K, V])
+		c.evict(least.entries.Back(), EvictedByCapacity)
+	}
+
+	first := c.buckets.Front()
+	if first == nil || first.Value.(*lfuBucket[K, V]).uses != 1 {
+		first = c.buckets.PushFront(&lfuBucket[K, V]{uses: 1, entries: list.New()})
+	}
+	entry := &lfuEntry[K, V]{cacheEntry: cacheEntry[K, V]{key: key, value: value, expiresAt: expiresAt}, bucket: first}
+	c.entries[key] = first.Value.(*lfuBucket[K, V]).entries.PushFront(entry)
+}
+
+// Remove deletes the entry of the key, returning whether it was cached.
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	element, ok := c.live(key)
+	if ok {
+		c.remove(element)
+	}
+	return ok
+}
+
+// Len returns the number of entries that didn't expire.
+func (c *LFUCache[K, V]) Len() int {
+	c.removeExpired()
+	return len(c.entries)
+}
+
+// Keys returns the keys of the entries that didn't expire, from the most to the least frequently used.
+// Keys with the same number of uses are sorted from the most to the least recently used.
+func (c *LFUCache[K, V]) Keys() []K {
+	c.removeExpired()
+	keys := make([]K, 0, len(c.entries))
+	for bucket := c.buckets.Back(); bucket != nil; bucket = bucket.Prev() {
+		for element := bucket.Value.(*lfuBucket[K, V]).entries.Front(); element != nil; element = element.Next() {
+			keys = append(keys, element.Value.(*lfuEntry[K, V]).key)
+		}
+	}
+	return keys
+}
+
+// Clear deletes every entry.
+func (c *LFUCache[K, V]) Clear() {
+	c.entries = make(map[K]*list.Element)
+	c.buckets.Init()
+}
+
+// use moves an entry to the bucket with one more use, creating it if needed.
+func (c *LFUCache[K, V]) use(element *list.Element) {
+	entry := element.Value.(*lfuEntry[K, V])
+	current := entry.bucket
+	uses := current.Value.(*lfuBucket[K, V]).uses
+
+	next := current.Next()
+	if next == nil || next.Value.(*lfuBucket[K, V]).uses != uses+1 {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{uses: uses + 1, entries: list.New()}, current)
+	}
+
+	c.remove(element)
+	entry.bucket = next
+	c.entries[entry.key] = next.Value.(*lfuBucket[K, V]).entries.PushFront(entry)
+}
+
+// live returns the element of the key, if it is cached and didn't expire. Expired entries are evicted.
+func (c *LFUCache[K, V]) live(key K) (*list.Element, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if element.Value.(*lfuEntry[K, V]).expired(c.clock.Now()) {
+		c.evict(element, EvictedByExpiration)
+		return nil, false
+	}
+	return element, true
+}
+
+// removeExpired evicts every expired entry, from the least to the most frequently used.
+func (c *LFUCache[K, V]) removeExpired() {
+	now := c.clock.Now()
+	for bucket := c.buckets.Front(); bucket != nil; {
+		nextBucket := bucket.Next()
+		entries := bucket.Value.(*lfuBucket[K, V]).entries
+		for element := entries.Back(); element != nil; {
+			previous := element.Prev()
+			if element.Value.(*lfuEntry[K, V]).expired(now) {
+				c.evict(element, EvictedByExpiration)
+			}
+			element = previous
+		}
+		bucket = nextBucket
+	}
+}
+
+// evict removes an entry and reports it to the OnEvict callback.
+func (c *LFUCache[K, V]) evict(element *list.Element, reason EvictionReason) {
+	entry := c.remove(element)
+	if c.options.OnEvict != nil {
+		c.options.OnEvict(entry.key, entry.value, reason)
+	}
+}
+
+// remove deletes an entry, along with its bucket if it becomes empty, and returns it.
+func (c *LFUCache[K, V]) remove(element *list.Element) *lfuEntry[K, V] {
+	entry := element.Value.(*lfuEntry[K, V])
+	bucket := entry.bucket.Value.(*lfuBucket[K, V])
+	bucket.entries.Remove(element)
+	if bucket.entries.Len() == 0 {
+		c.buckets.Remove(entry.bucket)
+	}
+	delete(c.entries, entry.key)
+	return entry
+}
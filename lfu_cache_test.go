@@ -0,0 +1,42 @@
+package godash
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLFUCache(t *testing.T) {
+	evicted := make([]string, 0)
+	cache := NewLFUCache(3, CacheOptions[string, int]{
+		OnEvict: func(key string, _ int, _ EvictionReason) { evicted = append(evicted, key) },
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("c")
+	cache.Peek("b")
+
+	if got := cache.Keys(); !slices.Equal(got, []string{"a", "c", "b"}) {
+		t.Errorf("Keys() = %v, want [a c b]", got)
+	}
+
+	// b is the least frequently used, then d is as it starts with a single use
+	cache.Put("d", 4)
+	cache.Put("e", 5)
+	if !slices.Equal(evicted, []string{"b", "d"}) {
+		t.Errorf("evicted %v, want [b d]", evicted)
+	}
+	if got := cache.Keys(); !slices.Equal(got, []string{"a", "c", "e"}) {
+		t.Errorf("Keys() = %v, want [a c e]", got)
+	}
+
+	if !cache.Remove("a") || cache.Remove("a") {
+		t.Error("Remove(a) should return true only the first time")
+	}
+	if got := cache.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+}
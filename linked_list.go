@@ -0,0 +1,310 @@
+package godash
+
+import "fmt"
+
+// LinkedList is a doubly linked list. Besides the methods it shares with [Slice], it gives access to its nodes,
+// so elements can be inserted and removed anywhere in constant time, and lists can be spliced in constant time.
+//
+// The zero value is an empty list. A LinkedList must not be copied after first use.
+type LinkedList[T any] struct {
+	head, tail *ListNode[T]
+	len        int
+	owner      *listOwner
+}
+
+// ListNode is a node of a [LinkedList], holding one of its elements.
+type ListNode[T any] struct {
+	// Value is the element held by the node.
+	Value      T
+	next, prev *ListNode[T]
+	owner      *listOwner
+}
+
+// listOwner identifies the list holding a node. When a list is spliced into another one, its owner is forwarded
+// to the owner of the other list instead of updating each node, so splicing takes constant time.
+type listOwner struct {
+	forward *listOwner
+}
+
+// resolve returns the owner the given one was forwarded to, shortening the chain of forwards on the way.
+func (o *listOwner) resolve() *listOwner {
+	root := o
+	for root.forward != nil {
+		root = root.forward
+	}
+	for o != root {
+		next := o.forward
+		o.forward = root
+		o = next
+	}
+	return root
+}
+
+// Next returns the next node of the list, or nil if n is the last one.
+func (n *ListNode[T]) Next() *ListNode[T] {
+	return n.next
+}
+
+// Prev returns the previous node of the list, or nil if n is the first one.
+func (n *ListNode[T]) Prev() *ListNode[T] {
+	return n.prev
+}
+
+// NewLinkedList creates a LinkedList with the specified elements.
+func NewLinkedList[T any](elements ...T) *LinkedList[T] {
+	l := &LinkedList[T]{}
+	l.Push(elements...)
+	return l
+}
+
+// LinkedListFromSlice creates a LinkedList with the elements of the slice, in order.
+func LinkedListFromSlice[T any, S ~[]T](s S) *LinkedList[T] {
+	return NewLinkedList(s...)
+}
+
+// Len returns the number of elements of the list.
+func (l *LinkedList[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first node of the list, or nil if it is empty.
+func (l *LinkedList[T]) Front() *ListNode[T] {
+	return l.head
+}
+
+// Back returns the last node of the list, or nil if it is empty.
+func (l *LinkedList[T]) Back() *ListNode[T] {
+	return l.tail
+}
+
+// Push adds the values at the end of the list, like [Slice.Push], and returns the new length of the list.
+func (l *LinkedList[T]) Push(values ...T) (length int) {
+	for _, value := range values {
+		l.PushBack(value)
+	}
+	return l.len
+}
+
+// Unshift adds the values at the beginning of the list, keeping their order, like [Slice.Unshift], and returns
+// the new length of the list.
+func (l *LinkedList[T]) Unshift(values ...T) (length int) {
+	for i := len(values) - 1; i >= 0; i-- {
+		l.PushFront(values[i])
+	}
+	return l.len
+}
+
+// Pop removes the last element of the list and returns it, or returns false if the list is empty.
+func (l *LinkedList[T]) Pop() (T, bool) {
+	if l.tail == nil {
+		var zero T
+		return zero, false
+	}
+	return l.Remove(l.tail), true
+}
+
+// Shift removes the first element of the list and returns it, or returns false if the list is empty.
+func (l *LinkedList[T]) Shift() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	return l.Remove(l.head), true
+}
+
+// PushBack adds the value at the end of the list and returns its node.
+func (l *LinkedList[T]) PushBack(value T) *ListNode[T] {
+	return l.insert(value, l.tail, nil)
+}
+
+// PushFront adds the value at the beginning of the list and returns its node.
+func (l *LinkedList[T]) PushFront(value T) *ListNode[T] {
+	return l.insert(value, nil, l.head)
+}
+
+// InsertBefore adds the value right before the mark and returns its node.
+// It panics if the mark isn't a node of the list.
+func (l *LinkedList[T]) InsertBefore(value T, mark *ListNode[T]) *ListNode[T] {
+	l.checkNode(mark)
+	return l.insert(value, mark.prev, mark)
+}
+
+// InsertAfter adds the value right after the mark and returns its node.
+// It panics if the mark isn't a node of the list.
+func (l *LinkedList[T]) InsertAfter(value T, mark *ListNode[T]) *ListNode[T] {
+	l.checkNode(mark)
+	return l.insert(value, mark, mark.next)
+}
+
+// Remove removes the node from the list and returns its value. It panics if the node isn't a node of the list.
+func (l *LinkedList[T]) Remove(node *ListNode[T]) T {
+	l.checkNode(node)
+	l.link(node.prev, node.next)
+	node.next, node.prev, node.owner = nil, nil, nil
+	l.len--
+	return node.Value
+}
+
+// Splice moves every element of other right after the mark, or to the end of the list if the mark is nil,
+// leaving other empty. The nodes of other become nodes of the list. It takes constant time, and panics if the
+// mark isn't a node of the list or if other is the list itself.
+func (l *LinkedList[T]) Splice(mark *ListNode[T], other *LinkedList[T]) {
+	if other == l {
+		panic("godash: LinkedList spliced into itself")
+	}
+	if mark == nil {
+		mark = l.tail
+	} else {
+		l.checkNode(mark)
+	}
+	if other.len == 0 {
+		return
+	}
+
+	var next *ListNode[T]
+	if mark == nil {
+		next = l.head
+	} else {
+		next = mark.next
+	}
+	l.link(mark, other.head)
+	l.link(other.tail, next)
+	l.len += other.len
+
+	other.ownerToken().forward = l.ownerToken()
+	*other = LinkedList[T]{}
+}
+
+// Reverse reverses the order of the elements of the list in place, and returns the list.
+func (l *LinkedList[T]) Reverse() *LinkedList[T] {
+	for node := l.head; node != nil; node = node.prev {
+		node.next, node.prev = node.prev, node.next
+	}
+	l.head, l.tail = l.tail, l.head
+	return l
+}
+
+// Find returns the first element of the list that satisfies the predicate, or false if there is none.
+func (l *LinkedList[T]) Find(p Predicate[T]) (T, bool) {
+	if node := l.FindNode(p); node != nil {
+		return node.Value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindNode returns the first node of the list whose value satisfies the predicate, or nil if there is none.
+func (l *LinkedList[T]) FindNode(p Predicate[T]) *ListNode[T] {
+	for node := l.head; node != nil; node = node.next {
+		if p(node.Value) {
+			return node
+		}
+	}
+	return nil
+}
+
+// Filter returns a new list with the elements of the list that satisfy the predicate, in order.
+func (l *LinkedList[T]) Filter(p Predicate[T]) *LinkedList[T] {
+	result := &LinkedList[T]{}
+	for node := l.head; node != nil; node = node.next {
+		if p(node.Value) {
+			result.PushBack(node.Value)
+		}
+	}
+	return result
+}
+
+// ForEach calls f with the index and the value of every element of the list, in order.
+func (l *LinkedList[T]) ForEach(f func(i int, v T)) {
+	i := 0
+	for node := l.head; node != nil; node = node.next {
+		f(i, node.Value)
+		i++
+	}
+}
+
+// Every checks whether every element of the list satisfies the predicate. It returns true for an empty list.
+func (l *LinkedList[T]) Every(p Predicate[T]) bool {
+	return l.FindNode(func(v T) bool { return !p(v) }) == nil
+}
+
+// Some checks whether any element of the list satisfies the predicate.
+func (l *LinkedList[T]) Some(p Predicate[T]) bool {
+	return l.FindNode(p) != nil
+}
+
+// Values returns a sequence of the elements of the list, from the first to the last one.
+func (l *LinkedList[T]) Values() Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.head; node != nil; node = node.next {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence of the elements of the list, from the last to the first one.
+func (l *LinkedList[T]) Backward() Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.tail; node != nil; node = node.prev {
+			if !yield(node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the elements of the list in a new Slice, in order.
+func (l *LinkedList[T]) ToSlice() Slice[T] {
+	result := make(Slice[T], 0, l.len)
+	for node := l.head; node != nil; node = node.next {
+		result = append(result, node.Value)
+	}
+	return result
+}
+
+// String formats the elements of the list like a slice.
+func (l *LinkedList[T]) String() string {
+	return fmt.Sprint(l.ToSlice())
+}
+
+// insert adds a node holding the value between prev and next, which are adjacent nodes of the list, or nil at
+// the ends of the list.
+func (l *LinkedList[T]) insert(value T, prev, next *ListNode[T]) *ListNode[T] {
+	node := &ListNode[T]{Value: value, owner: l.ownerToken()}
+	l.link(prev, node)
+	l.link(node, next)
+	l.len++
+	return node
+}
+
+// link makes next follow prev. Either can be nil, to update the ends of the list.
+func (l *LinkedList[T]) link(prev, next *ListNode[T]) {
+	if prev == nil {
+		l.head = next
+	} else {
+		prev.next = next
+	}
+	if next == nil {
+		l.tail = prev
+	} else {
+		next.prev = prev
+	}
+}
+
+// ownerToken returns the owner of the nodes of the list, creating it for a new list.
+func (l *LinkedList[T]) ownerToken() *listOwner {
+	if l.owner == nil {
+		l.owner = &listOwner{}
+	}
+	return l.owner
+}
+
+// checkNode panics if the node isn't a node of the list.
+func (l *LinkedList[T]) checkNode(node *ListNode[T]) {
+	if node == nil || node.owner == nil || node.owner.resolve() != l.ownerToken() {
+		panic("godash: the node doesn't belong to the LinkedList")
+	}
+	node.owner = l.owner
+}
@@ -0,0 +1,29 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleLinkedList() {
+	tasks := godash.NewLinkedList("write", "test")
+	review := tasks.InsertAfter("review", tasks.Front())
+	tasks.InsertBefore("lint", review)
+	tasks.Unshift("plan")
+
+	fmt.Println(tasks, tasks.Len())
+	// Output:
+	// [plan write lint review test] 5
+}
+
+func ExampleLinkedList_Splice() {
+	today := godash.NewLinkedList("standup", "deploy")
+	urgent := godash.NewLinkedList("hotfix", "rollback")
+	today.Splice(today.Front(), urgent)
+
+	fmt.Println(today, urgent.Len())
+	fmt.Println(godash.Collect(today.Backward()))
+	// Output:
+	// [standup hotfix rollback deploy] 0
+	// [deploy rollback hotfix standup]
+}
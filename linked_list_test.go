@@ -0,0 +1,193 @@
+package godash
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkList fails the test if the list doesn't hold the expected elements, in both directions.
+func checkList(t *testing.T, l *LinkedList[int], want []int) {
+	t.Helper()
+	if l.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	if got := l.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+	backward := Collect(l.Backward())
+	if !slices.Equal(backward, ToReversed(want)) {
+		t.Fatalf("Backward() = %v, want the reverse of %v", backward, want)
+	}
+}
+
+func TestLinkedList(t *testing.T) {
+	var l LinkedList[int]
+	checkList(t, &l, []int{})
+	if _, ok := l.Pop(); ok {
+		t.Error("Pop() of an empty list should be false")
+	}
+	if _, ok := l.Shift(); ok {
+		t.Error("Shift() of an empty list should be false")
+	}
+
+	if n := l.Push(3, 4); n != 2 {
+		t.Errorf("Push() = %d, want 2", n)
+	}
+	if n := l.Unshift(1, 2); n != 4 {
+		t.Errorf("Unshift() = %d, want 4", n)
+	}
+	checkList(t, &l, []int{1, 2, 3, 4})
+
+	if v, ok := l.Pop(); !ok || v != 4 {
+		t.Errorf("Pop() = %d, %t, want 4, true", v, ok)
+	}
+	if v, ok := l.Shift(); !ok || v != 1 {
+		t.Errorf("Shift() = %d, %t, want 1, true", v, ok)
+	}
+	checkList(t, &l, []int{2, 3})
+
+	three := l.FindNode(func(v int) bool { return v == 3 })
+	l.InsertBefore(25, three)
+	l.InsertAfter(35, three)
+	five := l.InsertAfter(5, l.Back())
+	checkList(t, &l, []int{2, 25, 3, 35, 5})
+
+	if v := l.Remove(three); v != 3 {
+		t.Errorf("Remove() = %d, want 3", v)
+	}
+	l.Remove(five)
+	checkList(t, &l, []int{2, 25, 35})
+	if l.Front().Value != 2 || l.Front().Next().Value != 25 || l.Back().Prev().Value != 25 || l.Front().Prev() != nil {
+		t.Error("the nodes aren't linked in order")
+	}
+
+	checkList(t, l.Reverse(), []int{35, 25, 2})
+	checkList(t, NewLinkedList[int]().Reverse(), []int{})
+}
+
+func TestLinkedList_Predicates(t *testing.T) {
+	l := LinkedListFromSlice([]int{1, 2, 3, 4})
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	checkList(t, l.Filter(isEven), []int{2, 4})
+	checkList(t, l, []int{1, 2, 3, 4})
+	if v, ok := l.Find(isEven); !ok || v != 2 {
+		t.Errorf("Find() = %d, %t, want 2, true", v, ok)
+	}
+	if _, ok := l.Find(func(v int) bool { return v > 4 }); ok {
+		t.Error("Find() found an element that doesn't exist")
+	}
+	if l.Every(isEven) || !l.Some(isEven) || !l.Every(func(v int) bool { return v > 0 }) {
+		t.Error("Every() and Some() don't match the elements")
+	}
+
+	var indexes, values []int
+	l.ForEach(func(i int, v int) {
+		indexes, values = append(indexes, i), append(values, v)
+	})
+	if !slices.Equal(indexes, []int{0, 1, 2, 3}) || !slices.Equal(values, []int{1, 2, 3, 4}) {
+		t.Errorf("ForEach() called with %v and %v", indexes, values)
+	}
+
+	var firstTwo []int
+	l.Values()(func(v int) bool {
+		firstTwo = append(firstTwo, v)
+		return len(firstTwo) < 2
+	})
+	if !slices.Equal(firstTwo, []int{1, 2}) {
+		t.Errorf("Values() yielded %v after being stopped, want [1 2]", firstTwo)
+	}
+	if got := l.String(); got != "[1 2 3 4]" {
+		t.Errorf("String() = %q, want %q", got, "[1 2 3 4]")
+	}
+}
+
+func TestLinkedList_Splice(t *testing.T) {
+	a := NewLinkedList(1, 2)
+	b := NewLinkedList(10, 20)
+	ten := b.Front()
+	a.Splice(a.Front(), b)
+	checkList(t, a, []int{1, 10, 20, 2})
+	checkList(t, b, []int{})
+
+	// The nodes of b now belong to a, so they can be used with a.
+	a.InsertAfter(15, ten)
+	a.Remove(ten)
+	checkList(t, a, []int{1, 15, 20, 2})
+
+	// b can still be used, and spliced again.
+	b.Push(30)
+	c := NewLinkedList(100)
+	c.Splice(nil, a)
+	c.Splice(nil, b)
+	c.Splice(nil, NewLinkedList[int]())
+	checkList(t, c, []int{100, 1, 15, 20, 2, 30})
+	c.Remove(c.FindNode(func(v int) bool { return v == 20 }))
+	checkList(t, c, []int{100, 1, 15, 2, 30})
+
+	empty := &LinkedList[int]{}
+	empty.Splice(nil, c)
+	checkList(t, empty, []int{100, 1, 15, 2, 30})
+}
+
+func TestLinkedList_Panics(t *testing.T) {
+	a, b := NewLinkedList(1), NewLinkedList(2)
+	removed := NewLinkedList(3)
+	node := removed.Front()
+	removed.Remove(node)
+
+	tests := map[string]func(){
+		"node of another list": func() { a.Remove(b.Front()) },
+		"removed node":         func() { removed.InsertAfter(4, node) },
+		"nil mark":             func() { a.InsertBefore(0, nil) },
+		"splice into itself":   func() { a.Splice(nil, a) },
+		"spliced node":         func() { c := NewLinkedList(5); n := c.Front(); a.Splice(nil, c); c.Remove(n) },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("didn't panic")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestLinkedList_Random(t *testing.T) {
+	// Random operations on a list are checked against the same operations on a slice.
+	r := rand.New(rand.NewPCG(5, 6))
+	l := &LinkedList[int]{}
+	var model []int
+
+	for step := 0; step < 6000; step += 3 {
+		// Values are unique, so nodes can be found by value.
+		switch op := r.IntN(6); {
+		case op == 0:
+			l.Push(step)
+			model = append(model, step)
+		case op == 1:
+			l.Unshift(step)
+			model = slices.Insert(model, 0, step)
+		case op == 2 && len(model) > 0:
+			i := r.IntN(len(model))
+			node := l.FindNode(func(v int) bool { return v == model[i] })
+			l.InsertAfter(step, node)
+			model = slices.Insert(model, i+1, step)
+		case op == 3 && len(model) > 0:
+			i := r.IntN(len(model))
+			l.Remove(l.FindNode(func(v int) bool { return v == model[i] }))
+			model = slices.Delete(model, i, i+1)
+		case op == 4:
+			l.Reverse()
+			slices.Reverse(model)
+		default:
+			other := NewLinkedList(step+1, step+2)
+			l.Splice(nil, other)
+			model = append(model, step+1, step+2)
+		}
+	}
+	checkList(t, l, model)
+}
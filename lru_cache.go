@@ -0,0 +1,149 @@
+package godash
+
+import (
+	"container/list"
+	"time"
+)
+
+// LRUCache is a [Cache] that evicts the least recently used entry when a new one doesn't fit.
+// Both Get and Put count as a use of an entry. It isn't safe for concurrent use: see [NewSyncCache].
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	options  CacheOptions[K, V]
+	clock    Clock
+	entries  map[K]*list.Element
+	recency  *list.List // of *cacheEntry[K, V], from the most to the least recently used
+}
+
+// NewLRUCache creates an empty LRUCache holding at most capacity entries, configured by the options.
+// Zero or a negative capacity means the cache is unbounded, so entries are only removed when they expire.
+func NewLRUCache[K comparable, V any](capacity int, options CacheOptions[K, V]) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		options:  options,
+		clock:    clockOrSystem(options.Clock),
+		entries:  make(map[K]*list.Element),
+		recency:  list.New(),
+	}
+}
+
+// Get returns the value cached for the key and marks it as the most recently used.
+// If the key isn't cached or its entry expired, it returns the zero value of type `V` and `false`.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	element, ok := c.live(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.recency.MoveToFront(element)
+	return element.Value.(*cacheEntry[K, V]).value, true
+}
+
+// Peek returns the value cached for the key without marking it as used.
+// If the key isn't cached or its entry expired, it returns the zero value of type `V` and `false`.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	element, ok := c.entries[key]
+	if !ok || element.Value.(*cacheEntry[K, V]).expired(c.clock.Now()) {
+		var zero V
+		return zero, false
+	}
+	return element.Value.(*cacheEntry[K, V]).value, true
+}
+
+// Put caches the value for the key with the default TTL of the cache and marks it as the most recently used.
+// If the key is new and the cache is full, the expired entries are evicted, or the least recently used entry if
+// none expired.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.options.TTL)
+}
+
+// PutWithTTL behaves like [LRUCache.Put], except the entry expires after ttl.
+// Zero or a negative ttl means the entry never expires.
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	entry := &cacheEntry[K, V]{key: key, value: value, expiresAt: expiration(c.clock, ttl)}
+	if element, ok := c.live(key); ok {
+		element.Value = entry
+		c.recency.MoveToFront(element)
+		return
+	}
+
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		// Expired entries may be anywhere in the list when their TTLs differ, and they make room first.
+		c.removeExpired()
+	}
+	if c.capacity > 0 && len(c.entries) >= c.capacity {
+		c.evict(c.recency.Back(), EvictedByCapacity)
+	}
+	c.entries[key] = c.recency.PushFront(entry)
+}
+
+// Remove deletes the entry of the key, returning whether it was cached.
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	element, ok := c.live(key)
+	if ok {
+		c.remove(element)
+	}
+	return ok
+}
+
+// Len returns the number of entries that didn't expire.
+func (c *LRUCache[K, V]) Len() int {
+	c.removeExpired()
+	return len(c.entries)
+}
+
+// Keys returns the keys of the entries that didn't expire, from the most to the least recently used.
+func (c *LRUCache[K, V]) Keys() []K {
+	c.removeExpired()
+	keys := make([]K, 0, len(c.entries))
+	for element := c.recency.Front(); element != nil; element = element.Next() {
+		keys = append(keys, element.Value.(*cacheEntry[K, V]).key)
+	}
+	return keys
+}
+
+// Clear deletes every entry.
+func (c *LRUCache[K, V]) Clear() {
+	c.entries = make(map[K]*list.Element)
+	c.recency.Init()
+}
+
+// live returns the element of the key, if it is cached and didn't expire. Expired entries are evicted.
+func (c *LRUCache[K, V]) live(key K) (*list.Element, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if element.Value.(*cacheEntry[K, V]).expired(c.clock.Now()) {
+		c.evict(element, EvictedByExpiration)
+		return nil, false
+	}
+	return element, true
+}
+
+// removeExpired evicts every expired entry, from the least to the most recently used.
+func (c *LRUCache[K, V]) removeExpired() {
+	now := c.clock.Now()
+	for element := c.recency.Back(); element != nil; {
+		previous := element.Prev()
+		if element.Value.(*cacheEntry[K, V]).expired(now) {
+			c.evict(element, EvictedByExpiration)
+		}
+		element = previous
+	}
+}
+
+// evict removes an entry and reports it to the OnEvict callback.
+func (c *LRUCache[K, V]) evict(element *list.Element, reason EvictionReason) {
+	entry := c.remove(element)
+	if c.options.OnEvict != nil {
+		c.options.OnEvict(entry.key, entry.value, reason)
+	}
+}
+
+// remove deletes an entry and returns it.
+func (c *LRUCache[K, V]) remove(element *list.Element) *cacheEntry[K, V] {
+	entry := c.recency.Remove(element).(*cacheEntry[K, V])
+	delete(c.entries, entry.key)
+	return entry
+}
@@ -0,0 +1,36 @@
+package godash
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cache := NewLRUCache(0, CacheOptions[string, int]{TTL: time.Minute, Clock: clock})
+
+	t.Run("zero capacity is unbounded", func(t *testing.T) {
+		for i, key := range []string{"a", "b", "c", "d"} {
+			cache.Put(key, i)
+		}
+		if got := cache.Keys(); !slices.Equal(got, []string{"d", "c", "b", "a"}) {
+			t.Errorf("Keys() = %v, want [d c b a]", got)
+		}
+	})
+
+	t.Run("Put uses the default TTL", func(t *testing.T) {
+		cache.PutWithTTL("e", 4, time.Hour)
+		clock.Advance(time.Minute)
+		if got := cache.Keys(); !slices.Equal(got, []string{"e"}) {
+			t.Errorf("Keys() = %v, want [e]", got)
+		}
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		cache.Clear()
+		if _, ok := cache.Get("e"); ok || cache.Len() != 0 {
+			t.Errorf("cache has %d entries after Clear(), want 0", cache.Len())
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package godash
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrGoexit is returned by [Memoized.Get] to the calls that waited for a call whose wrapped function called
+// [runtime.Goexit], such as t.FailNow in a test. Nothing is cached for that call.
+var ErrGoexit = errors.New("memoized function called runtime.Goexit")
+
+// MemoizeOptions configures the cache used by [Memoize] and [MemoizeMust].
+// The zero value is an unbounded cache whose entries never expire and that doesn't keep errors.
+type MemoizeOptions struct {
+	// Capacity limits the number of cached entries. When it is reached, the least recently used entry is evicted.
+	// Zero or a negative value means the cache is unbounded.
+	Capacity int
+	// TTL is how long an entry stays cached after it was computed. Zero or a negative value means entries
+	// never expire.
+	TTL time.Duration
+	// Clock tells the time used to expire entries. If nil, the system clock is used.
+	Clock Clock
+	// CacheErrors caches failed calls like successful ones. By default, errors are returned to the callers
+	// but not cached, so the next call for the same key tries again.
+	CacheErrors bool
+}
+
+// CacheStats reports how the calls to a memoized function were served.
+type CacheStats struct {
+	// Hits is the number of calls served from the cache.
+	Hits uint64
+	// Misses is the number of calls that ran the wrapped function.
+	Misses uint64
+	// Shared is the number of calls that waited for a concurrent call with the same key instead of running
+	// the wrapped function again.
+	Shared uint64
+	// Evictions is the number of entries removed from the cache to respect its capacity.
+	Evictions uint64
+}
+
+// Memoized wraps a [Mapper] caching its results by key. It is created by [Memoize] or [MemoizeMust].
+//
+// It is safe for concurrent use. Concurrent calls with a key that is being computed wait for that computation
+// and share its result, instead of calling the wrapped function again.
+type Memoized[K comparable, V any] struct {
+	mapper  Mapper[K, V]
+	options MemoizeOptions
+
+	mu       sync.Mutex
+	cache    *LRUCache[K, memoResult[V]]
+	inFlight map[K]*memoCall[V]
+	stats    CacheStats
+}
+
+// memoResult is a cached result of a Memoized function.
+type memoResult[V any] struct {
+	value V
+	err   error
+}
+
+// memoCall is a call of a Memoized function that is still running.
+type memoCall[V any] struct {
+	done     chan struct{}
+	value    V
+	err      error
+	panicked bool
+	panicVal any
+}
+
+// Memoize wraps the mapper caching its results by key, as configured by the options.
+// Use [Memoized.Get] wherever a Mapper is expected:
+//
+//	lookup := Memoize(expensiveLookup, MemoizeOptions{Capacity: 100})
+//	results, err := Map(keys, lookup.Get)
+func Memoize[K comparable, V any](mapper Mapper[K, V], options MemoizeOptions) *Memoized[K, V] {
+	m := &Memoized[K, V]{
+		mapper:   mapper,
+		options:  options,
+		inFlight: make(map[K]*memoCall[V]),
+	}
+	m.cache = NewLRUCache(options.Capacity, CacheOptions[K, memoResult[V]]{
+		TTL:   options.TTL,
+		Clock: options.Clock,
+		OnEvict: func(_ K, _ memoResult[V], reason EvictionReason) {
+			if reason == EvictedByCapacity {
+				m.stats.Evictions++
+			}
+		},
+	})
+	return m
+}
+
+// MemoizeMust behaves like [Memoize] for a [MustMapper]. Use [Memoized.MustGet] wherever a MustMapper is expected.
+func MemoizeMust[K comparable, V any](mapper MustMapper[K, V], options MemoizeOptions) *Memoized[K, V] {
+	return Memoize(func(key K) (V, error) {
+		return mapper(key), nil
+	}, options)
+}
+
+// Get returns the result of the wrapped function for the key, computing it only if it isn't cached.
+// It has the signature of a [Mapper], so m.Get can be used wherever a Mapper is expected.
+// If the wrapped function panics, the panic is propagated to every call waiting for its result, and if it calls
+// [runtime.Goexit], the waiting calls return [ErrGoexit]. Neither outcome is cached.
+func (m *Memoized[K, V]) Get(key K) (V, error) {
+	m.mu.Lock()
+	if cached, ok := m.cache.Get(key); ok {
+		m.stats.Hits++
+		m.mu.Unlock()
+		return cached.value, cached.err
+	}
+	if call, ok := m.inFlight[key]; ok {
+		m.stats.Shared++
+		m.mu.Unlock()
+		<-call.done
+		return call.result()
+	}
+
+	call := &memoCall[V]{done: make(chan struct{})}
+	m.inFlight[key] = call
+	m.stats.Misses++
+	m.mu.Unlock()
+
+	normalReturn := false
+	defer func() {
+		if r := recover(); r != nil {
+			call.panicked, call.panicVal = true, r
+		} else if !normalReturn {
+			// Nothing was recovered, so the wrapped function called runtime.Goexit, which goes on after this.
+			call.err = ErrGoexit
+		}
+
+		m.mu.Lock()
+		delete(m.inFlight, key)
+		if normalReturn && (call.err == nil || m.options.CacheErrors) {
+			m.cache.Put(key, memoResult[V]{value: call.value, err: call.err})
+		}
+		m.mu.Unlock()
+		close(call.done)
+
+		if call.panicked {
+			panic(call.panicVal)
+		}
+	}()
+
+	call.value, call.err = m.mapper(key)
+	normalReturn = true
+	return call.value, call.err
+}
+
+// MustGet behaves like [Memoized.Get], except it panics if the wrapped function returns an error.
+// It has the signature of a [MustMapper], so m.MustGet can be used wherever a MustMapper is expected.
+func (m *Memoized[K, V]) MustGet(key K) V {
+	return MapperToMustMapper(m.Get)(key)
+}
+
+// Forget removes the cached result for the key, if any, so the next call computes it again.
+func (m *Memoized[K, V]) Forget(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cache.Remove(key)
+}
+
+// Reset removes every cached result. The statistics are kept.
+func (m *Memoized[K, V]) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cache.Clear()
+}
+
+// Len returns the number of cached results that didn't expire.
+func (m *Memoized[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cache.Len()
+}
+
+// Stats returns how the calls so far were served.
+func (m *Memoized[K, V]) Stats() CacheStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stats
+}
+
+// result returns the outcome of a finished call, re-panicking if the wrapped function panicked.
+func (c *memoCall[V]) result() (V, error) {
+	if c.panicked {
+		panic(c.panicVal)
+	}
+	return c.value, c.err
+}
@@ -0,0 +1,33 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+	"strings"
+)
+
+func ExampleMemoize() {
+	lookups := 0
+	lookupUser := func(id int) (string, error) {
+		lookups++
+		return fmt.Sprintf("user-%d", id), nil
+	}
+
+	memo := godash.Memoize(lookupUser, godash.MemoizeOptions{Capacity: 100})
+	users, err := godash.Map([]int{1, 2, 1, 1, 2}, memo.Get)
+
+	fmt.Println(users, err)
+	fmt.Println("lookups:", lookups)
+	fmt.Printf("%+v\n", memo.Stats())
+	// Output:
+	// [user-1 user-2 user-1 user-1 user-2] <nil>
+	// lookups: 2
+	// {Hits:3 Misses:2 Shared:0 Evictions:0}
+}
+
+func ExampleMemoizeMust() {
+	memo := godash.MemoizeMust(strings.ToUpper, godash.MemoizeOptions{})
+	fmt.Println(godash.MustMap([]string{"a", "b", "a"}, memo.MustGet))
+	// Output:
+	// [A B A]
+}
@@ -0,0 +1,281 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingMapper returns a Mapper that formats its input and counts how many times it was called for each key.
+func countingMapper(calls map[int]int) Mapper[int, string] {
+	return func(i int) (string, error) {
+		calls[i]++
+		if i < 0 {
+			return "", fmt.Errorf("negative key %d", i)
+		}
+		return strconv.Itoa(i), nil
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	calls := make(map[int]int)
+	memo := Memoize(countingMapper(calls), MemoizeOptions{})
+
+	for i := 0; i < 3; i++ {
+		got, err := memo.Get(1)
+		if got != "1" || err != nil {
+			t.Fatalf("Get(1) = %q, %v, want \"1\", nil", got, err)
+		}
+	}
+
+	if calls[1] != 1 {
+		t.Errorf("mapper was called %d times, want 1", calls[1])
+	}
+	if got, want := memo.Stats(), (CacheStats{Hits: 2, Misses: 1}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+
+	t.Run("can be used as a Mapper", func(t *testing.T) {
+		got, err := Map([]int{1, 2, 1}, memo.Get)
+		if err != nil || fmt.Sprint(got) != "[1 2 1]" {
+			t.Errorf("Map() = %v, %v, want [1 2 1], nil", got, err)
+		}
+		if calls[2] != 1 {
+			t.Errorf("mapper was called %d times for 2, want 1", calls[2])
+		}
+	})
+}
+
+func TestMemoize_Errors(t *testing.T) {
+	t.Run("errors aren't cached by default", func(t *testing.T) {
+		calls := make(map[int]int)
+		memo := Memoize(countingMapper(calls), MemoizeOptions{})
+
+		for i := 0; i < 2; i++ {
+			if _, err := memo.Get(-1); err == nil {
+				t.Fatal("Get(-1) didn't return an error")
+			}
+		}
+		if calls[-1] != 2 {
+			t.Errorf("mapper was called %d times, want 2", calls[-1])
+		}
+		if memo.Len() != 0 {
+			t.Errorf("Len() = %d, want 0", memo.Len())
+		}
+	})
+
+	t.Run("errors are cached when asked to", func(t *testing.T) {
+		calls := make(map[int]int)
+		memo := Memoize(countingMapper(calls), MemoizeOptions{CacheErrors: true})
+
+		for i := 0; i < 2; i++ {
+			if _, err := memo.Get(-1); err == nil {
+				t.Fatal("Get(-1) didn't return an error")
+			}
+		}
+		if calls[-1] != 1 {
+			t.Errorf("mapper was called %d times, want 1", calls[-1])
+		}
+	})
+}
+
+func TestMemoize_LRU(t *testing.T) {
+	calls := make(map[int]int)
+	memo := Memoize(countingMapper(calls), MemoizeOptions{Capacity: 2})
+
+	for _, key := range []int{1, 2, 1, 3, 1, 2} {
+		if _, err := memo.Get(key); err != nil {
+			t.Fatalf("Get(%d) returned %v", key, err)
+		}
+	}
+
+	// 2 is evicted when 3 is added, as 1 was used more recently, then 3 is evicted when 2 is added again
+	if want := map[int]int{1: 1, 2: 2, 3: 1}; fmt.Sprint(calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+	if got, want := memo.Stats(), (CacheStats{Hits: 2, Misses: 4, Evictions: 2}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+	if memo.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", memo.Len())
+	}
+}
+
+func TestMemoize_TTL(t *testing.T) {
+	calls := make(map[int]int)
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	memo := Memoize(countingMapper(calls), MemoizeOptions{TTL: time.Minute, Clock: clock})
+
+	_, _ = memo.Get(1)
+	clock.Advance(59 * time.Second)
+	_, _ = memo.Get(1)
+	if calls[1] != 1 {
+		t.Errorf("mapper was called %d times before the TTL, want 1", calls[1])
+	}
+
+	clock.Advance(time.Second)
+	_, _ = memo.Get(1)
+	if calls[1] != 2 {
+		t.Errorf("mapper was called %d times after the TTL, want 2", calls[1])
+	}
+	if got, want := memo.Stats(), (CacheStats{Hits: 1, Misses: 2}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMemoize_ForgetAndReset(t *testing.T) {
+	calls := make(map[int]int)
+	memo := Memoize(countingMapper(calls), MemoizeOptions{})
+
+	_, _ = memo.Get(1)
+	_, _ = memo.Get(2)
+	memo.Forget(1)
+	memo.Forget(3)
+	_, _ = memo.Get(1)
+	_, _ = memo.Get(2)
+	if calls[1] != 2 || calls[2] != 1 {
+		t.Errorf("calls = %v, want 1 to be computed twice and 2 once", calls)
+	}
+
+	memo.Reset()
+	if memo.Len() != 0 {
+		t.Errorf("Len() = %d after Reset(), want 0", memo.Len())
+	}
+	_, _ = memo.Get(2)
+	if calls[2] != 2 {
+		t.Errorf("mapper was called %d times for 2 after Reset(), want 2", calls[2])
+	}
+}
+
+func TestMemoize_InFlightDeduplication(t *testing.T) {
+	const callers = 10
+	release := make(chan struct{})
+	var mu sync.Mutex
+	calls := 0
+
+	memo := Memoize(func(key string) (int, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		<-release
+		return len(key), nil
+	}, MemoizeOptions{})
+
+	var wg sync.WaitGroup
+	results := make([]int, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = memo.Get("abc")
+		}(i)
+	}
+
+	for memo.Stats().Shared+memo.Stats().Misses < callers {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("mapper was called %d times, want 1", calls)
+	}
+	for i, result := range results {
+		if result != 3 {
+			t.Errorf("caller %d got %d, want 3", i, result)
+		}
+	}
+	if got, want := memo.Stats(), (CacheStats{Misses: 1, Shared: callers - 1}); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMemoize_Panics(t *testing.T) {
+	errBoom := errors.New("boom")
+	memo := Memoize(func(int) (int, error) { panic(errBoom) }, MemoizeOptions{})
+
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r != errBoom {
+					t.Errorf("Get() panicked with %v, want %v", r, errBoom)
+				}
+			}()
+			_, _ = memo.Get(1)
+		}()
+	}
+	if got := memo.Stats().Misses; got != 2 {
+		t.Errorf("Stats().Misses = %d, want 2 as panics aren't cached", got)
+	}
+}
+
+func TestMemoize_Goexit(t *testing.T) {
+	release := make(chan struct{})
+	exits := true
+	memo := Memoize(func(i int) (int, error) {
+		if exits {
+			<-release
+			runtime.Goexit()
+		}
+		return i * 2, nil
+	}, MemoizeOptions{CacheErrors: true})
+
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		_, _ = memo.Get(1)
+	}()
+	for memo.Stats().Misses == 0 {
+		runtime.Gosched()
+	}
+
+	type result struct {
+		value int
+		err   error
+	}
+	waited := make(chan result)
+	go func() {
+		value, err := memo.Get(1)
+		waited <- result{value, err}
+	}()
+	for memo.Stats().Shared == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	<-exited
+
+	if got := <-waited; got.value != 0 || !errors.Is(got.err, ErrGoexit) {
+		t.Errorf("the waiting Get() = %d, %v, want 0, %v", got.value, got.err, ErrGoexit)
+	}
+	exits = false
+	if got, err := memo.Get(1); got != 2 || err != nil {
+		t.Errorf("Get() = %d, %v, want 2, nil as exits aren't cached", got, err)
+	}
+}
+
+func TestMemoizeMust(t *testing.T) {
+	calls := 0
+	memo := MemoizeMust(func(i int) int {
+		calls++
+		return i * i
+	}, MemoizeOptions{})
+
+	got := MustMap([]int{2, 3, 2, 3}, memo.MustGet)
+	if fmt.Sprint(got) != "[4 9 4 9]" || calls != 2 {
+		t.Errorf("MustMap() = %v with %d calls, want [4 9 4 9] with 2 calls", got, calls)
+	}
+
+	t.Run("MustGet panics on errors", func(t *testing.T) {
+		failing := Memoize(func(int) (int, error) { return 0, errors.New("failed") }, MemoizeOptions{})
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustGet() didn't panic")
+			}
+		}()
+		failing.MustGet(1)
+	})
+}
@@ -0,0 +1,235 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+var (
+	// ErrMergeNotComparable is returned by [DeepMerge] when slices are merged with [MergeSlicesUnion]
+	// but their elements can't be compared.
+	ErrMergeNotComparable = errors.New("slice elements aren't comparable")
+	// ErrMergeNilDestination is returned by [DeepMerge] when the destination pointer is nil.
+	ErrMergeNilDestination = errors.New("merge destination is nil")
+)
+
+// SliceMergeStrategy tells [DeepMerge] how to combine a slice of the destination with a slice of the source.
+type SliceMergeStrategy int
+
+const (
+	// MergeSlicesReplace replaces the slice of the destination with a copy of the slice of the source.
+	MergeSlicesReplace SliceMergeStrategy = iota
+	// MergeSlicesAppend appends the elements of the slice of the source to the slice of the destination.
+	MergeSlicesAppend
+	// MergeSlicesUnion appends the elements of the slice of the source that aren't in the slice of the
+	// destination yet, like a union of sets, keeping the order of the elements.
+	MergeSlicesUnion
+)
+
+// NilMergeStrategy tells [DeepMerge] what to do with nil and zero values of the source.
+type NilMergeStrategy int
+
+const (
+	// MergeNilSkip keeps the value of the destination when the value of the source is nil, following lodash's
+	// merge, which skips undefined values. Keys of maps missing in the destination are still added.
+	MergeNilSkip NilMergeStrategy = iota
+	// MergeNilOverwrite sets the value of the destination to nil when the value of the source is nil.
+	MergeNilOverwrite
+	// MergeZeroSkip keeps the value of the destination when the value of the source is the zero value of its type,
+	// including empty strings and zero numbers, which is useful to merge structs whose unset fields are zero.
+	MergeZeroSkip
+)
+
+// MergeOptions configures [DeepMerge]. The zero value replaces slices and skips nil values of the source.
+type MergeOptions struct {
+	Slices SliceMergeStrategy
+	Nil    NilMergeStrategy
+}
+
+// DeepMerge merges src into the value pointed to by dst, following lodash's merge: maps and structs are merged
+// recursively, key by key and field by field, as are pointers and arrays, while slices are combined as configured
+// by the options. Any other value of src, including values held by interfaces with a different type than the
+// one of dst, replaces the value of dst. The values taken from src are copied with [DeepClone], so dst doesn't
+// share anything with src afterward.
+//
+// Unexported struct fields are left unchanged. If dst is nil, [ErrMergeNilDestination] is returned. If another
+// error is returned, dst may be partially merged.
+func DeepMerge[T any](dst *T, src T, options MergeOptions) error {
+	if dst == nil {
+		return fmt.Errorf("%w: DeepMerge(nil %v)", ErrMergeNilDestination, reflect.TypeFor[*T]())
+	}
+	m := merger{options: options, visited: NewSet[mergeKey](), slices: map[mergeKey]reflect.Value{}}
+	return m.merge(reflect.ValueOf(dst).Elem(), reflect.ValueOf(&src).Elem(), "")
+}
+
+// merger merges values, keeping track of the pointers and maps already merged so cycles end, and of the slices
+// already merged so the ones shared in the source are merged once.
+type merger struct {
+	options MergeOptions
+	visited Set[mergeKey]
+	slices  map[mergeKey]reflect.Value // the result of merging every pair of slices
+}
+
+// mergeKey identifies a pointer, a map or a slice of the source already merged into one of the destination.
+// Slices are also identified by their lengths, as slices of different lengths may share their first element.
+type mergeKey struct {
+	dst, src       unsafe.Pointer
+	dstLen, srcLen int
+	t              reflect.Type
+}
+
+// newMergeKey returns the key of the pair of pointers, maps or slices.
+func newMergeKey(dst, src reflect.Value) mergeKey {
+	key := mergeKey{dst: dst.UnsafePointer(), src: src.UnsafePointer(), t: src.Type()}
+	if src.Kind() == reflect.Slice {
+		key.dstLen, key.srcLen = dst.Len(), src.Len()
+	}
+	return key
+}
+
+// merge merges src into dst, which must be settable and of the same type. The path, written like the ones
+// of [GetPath], locates dst in the value being merged, for error messages.
+func (m merger) merge(dst, src reflect.Value, path string) error {
+	if m.skip(src) {
+		return nil
+	}
+	if (isNillable(src.Kind()) && src.IsNil()) || (isNillable(dst.Kind()) && dst.IsNil()) {
+		dst.Set(deepCloneValue(src))
+		return nil
+	}
+
+	switch src.Kind() {
+	case reflect.Pointer, reflect.Map:
+		// Pointers and maps are merged in place, so merging the same pair again would change nothing.
+		key := newMergeKey(dst, src)
+		if m.visited.Has(key) {
+			return nil
+		}
+		m.visited.Add(key)
+		if src.Kind() == reflect.Map {
+			return m.mergeMaps(dst, src, path)
+		}
+		return m.merge(dst.Elem(), src.Elem(), path)
+	case reflect.Slice:
+		// Slices are replaced by a merged copy, which is reused for every occurrence of the same pair.
+		key := newMergeKey(dst, src)
+		if merged, ok := m.slices[key]; ok {
+			dst.Set(merged)
+			return nil
+		}
+		if err := m.mergeSlices(dst, src, path); err != nil {
+			return err
+		}
+		merged := reflect.New(dst.Type()).Elem()
+		merged.Set(dst)
+		m.slices[key] = merged
+		return nil
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			if err := m.merge(dst.Index(i), src.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			if field := dst.Field(i); field.CanSet() {
+				if err := m.merge(field, src.Field(i), joinPath(path, src.Type().Field(i).Name)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case reflect.Interface:
+		if dst.Elem().Type() != src.Elem().Type() {
+			dst.Set(deepCloneValue(src))
+			return nil
+		}
+		inner := reflect.New(dst.Elem().Type()).Elem()
+		inner.Set(dst.Elem())
+		if err := m.merge(inner, src.Elem(), path); err != nil {
+			return err
+		}
+		dst.Set(inner)
+		return nil
+	default:
+		dst.Set(src)
+		return nil
+	}
+}
+
+// skip checks whether the value of the source must be skipped according to the nil strategy.
+func (m merger) skip(src reflect.Value) bool {
+	switch m.options.Nil {
+	case MergeNilOverwrite:
+		return false
+	case MergeZeroSkip:
+		return src.IsZero()
+	default:
+		return isNillable(src.Kind()) && src.IsNil()
+	}
+}
+
+// mergeMaps merges the entries of the src map into the dst map. Missing keys are always added, even if their
+// values would be skipped by the nil strategy.
+func (m merger) mergeMaps(dst, src reflect.Value, path string) error {
+	for iter := src.MapRange(); iter.Next(); {
+		key, value := iter.Key(), iter.Value()
+		existing := dst.MapIndex(key)
+		if !existing.IsValid() {
+			dst.SetMapIndex(deepCloneValue(key), deepCloneValue(value))
+			continue
+		}
+
+		merged := reflect.New(existing.Type()).Elem()
+		merged.Set(existing)
+		if err := m.merge(merged, value, joinPath(path, fmt.Sprint(key))); err != nil {
+			return err
+		}
+		dst.SetMapIndex(key, merged)
+	}
+	return nil
+}
+
+// mergeSlices combines the src slice with the dst slice according to the slice strategy.
+func (m merger) mergeSlices(dst, src reflect.Value, path string) error {
+	switch m.options.Slices {
+	case MergeSlicesAppend:
+		merged := reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len())
+		dst.Set(reflect.AppendSlice(reflect.AppendSlice(merged, dst), deepCloneValue(src)))
+	case MergeSlicesUnion:
+		// The elements of dst are kept as they are, only the ones appended from src are copied.
+		seen := NewSet[any]()
+		merged := reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len())
+		for j, s := range []reflect.Value{dst, src} {
+			for i := 0; i < s.Len(); i++ {
+				element := s.Index(i)
+				if !element.Comparable() {
+					return fmt.Errorf("%w: found a %v at %q", ErrMergeNotComparable, indirectInterface(element).Type(), path)
+				}
+				if seen.Has(element.Interface()) {
+					continue
+				}
+				seen.Add(element.Interface())
+				if j == 1 {
+					element = deepCloneValue(element)
+				}
+				merged = reflect.Append(merged, element)
+			}
+		}
+		dst.Set(merged)
+	default:
+		dst.Set(deepCloneValue(src))
+	}
+	return nil
+}
+
+// joinPath appends a key to a path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
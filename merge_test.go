@@ -0,0 +1,210 @@
+package godash
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type mergeConfig struct {
+	Name    string
+	Port    int
+	Tags    []string
+	Limits  map[string]int
+	Backend *mergeBackend
+}
+
+type mergeBackend struct {
+	Host    string
+	Timeout int
+}
+
+func TestDeepMerge(t *testing.T) {
+	base := func() mergeConfig {
+		return mergeConfig{
+			Name:    "api",
+			Port:    80,
+			Tags:    []string{"a", "b"},
+			Limits:  map[string]int{"cpu": 1, "memory": 512},
+			Backend: &mergeBackend{Host: "localhost", Timeout: 30},
+		}
+	}
+	override := mergeConfig{
+		Port:    8080,
+		Tags:    []string{"b", "c"},
+		Limits:  map[string]int{"memory": 1024, "disk": 10},
+		Backend: &mergeBackend{Host: "db"},
+	}
+
+	tests := []struct {
+		name    string
+		options MergeOptions
+		want    mergeConfig
+	}{{
+		name: "default options",
+		want: mergeConfig{
+			Port:    8080,
+			Tags:    []string{"b", "c"},
+			Limits:  map[string]int{"cpu": 1, "memory": 1024, "disk": 10},
+			Backend: &mergeBackend{Host: "db"},
+		},
+	}, {
+		name:    "appending slices and skipping zero values",
+		options: MergeOptions{Slices: MergeSlicesAppend, Nil: MergeZeroSkip},
+		want: mergeConfig{
+			Name:    "api",
+			Port:    8080,
+			Tags:    []string{"a", "b", "b", "c"},
+			Limits:  map[string]int{"cpu": 1, "memory": 1024, "disk": 10},
+			Backend: &mergeBackend{Host: "db", Timeout: 30},
+		},
+	}, {
+		name:    "union of slices",
+		options: MergeOptions{Slices: MergeSlicesUnion, Nil: MergeZeroSkip},
+		want: mergeConfig{
+			Name:    "api",
+			Port:    8080,
+			Tags:    []string{"a", "b", "c"},
+			Limits:  map[string]int{"cpu": 1, "memory": 1024, "disk": 10},
+			Backend: &mergeBackend{Host: "db", Timeout: 30},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := base()
+			if err := DeepMerge(&dst, override, tt.options); err != nil {
+				t.Fatalf("DeepMerge() returned %v", err)
+			}
+			if !reflect.DeepEqual(dst, tt.want) {
+				t.Errorf("DeepMerge() = %+v, want %+v", dst, tt.want)
+			}
+
+			dst.Tags[len(dst.Tags)-1] = "changed"
+			dst.Backend.Host = "changed"
+			if override.Tags[1] != "c" || override.Backend.Host != "db" {
+				t.Error("changing the result changed the source")
+			}
+		})
+	}
+}
+
+func TestDeepMerge_NilStrategies(t *testing.T) {
+	decode := func(s string) map[string]any {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	dst := `{"a": {"b": 1, "c": [1, 2]}, "d": "text", "e": 5}`
+	src := `{"a": {"b": null, "c": [3]}, "d": null, "f": null, "e": {"nested": true}}`
+
+	tests := []struct {
+		name     string
+		strategy NilMergeStrategy
+		want     string
+	}{
+		{"skip", MergeNilSkip, `{"a":{"b":1,"c":[3]},"d":"text","e":{"nested":true},"f":null}`},
+		{"overwrite", MergeNilOverwrite, `{"a":{"b":null,"c":[3]},"d":null,"e":{"nested":true},"f":null}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := decode(dst)
+			if err := DeepMerge(&merged, decode(src), MergeOptions{Nil: tt.strategy}); err != nil {
+				t.Fatalf("DeepMerge() returned %v", err)
+			}
+			if got, _ := json.Marshal(merged); string(got) != tt.want {
+				t.Errorf("DeepMerge() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeepMerge_UnionKeepsDestination(t *testing.T) {
+	a, b := 1, 2
+	dst := []*int{&a}
+	src := []*int{&a, &b}
+
+	if err := DeepMerge(&dst, src, MergeOptions{Slices: MergeSlicesUnion}); err != nil {
+		t.Fatalf("DeepMerge() returned %v", err)
+	}
+	if len(dst) != 2 || dst[0] != &a || dst[1] == &b || *dst[1] != b {
+		t.Errorf("DeepMerge() = %v, want the element of dst followed by a copy of the new element of src", dst)
+	}
+}
+
+func TestDeepMerge_Errors(t *testing.T) {
+	dst := map[string]any{"items": []any{map[string]any{"id": 1}}}
+	src := map[string]any{"items": []any{map[string]any{"id": 2}}}
+
+	err := DeepMerge(&dst, src, MergeOptions{Slices: MergeSlicesUnion})
+	if !errors.Is(err, ErrMergeNotComparable) {
+		t.Fatalf("DeepMerge() returned %v, want %v", err, ErrMergeNotComparable)
+	}
+	if want := `slice elements aren't comparable: found a map[string]interface {} at "items"`; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestDeepMerge_NilDestination(t *testing.T) {
+	var dst *map[string]int
+	err := DeepMerge(dst, map[string]int{"a": 1}, MergeOptions{})
+	if !errors.Is(err, ErrMergeNilDestination) {
+		t.Fatalf("DeepMerge() returned %v, want %v", err, ErrMergeNilDestination)
+	}
+	if want := "merge destination is nil: DeepMerge(nil *map[string]int)"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestDeepMerge_Cycles(t *testing.T) {
+	src := &cloneNode{Value: 1}
+	src.Parent = src
+	dst := &cloneNode{Value: 0, Parent: &cloneNode{Value: 5}}
+
+	if err := DeepMerge(&dst, src, MergeOptions{}); err != nil {
+		t.Fatalf("DeepMerge() returned %v", err)
+	}
+	if dst.Value != 1 || dst.Parent.Value != 1 {
+		t.Errorf("DeepMerge() = %+v, want the values of src", dst)
+	}
+}
+
+func TestDeepMerge_MapCycles(t *testing.T) {
+	dst := map[string]any{"a": 1}
+	dst["self"] = dst
+	src := map[string]any{"b": 2}
+	src["self"] = src
+
+	done := make(chan error)
+	go func() { done <- DeepMerge(&dst, src, MergeOptions{}) }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("DeepMerge() returned %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DeepMerge() didn't end")
+	}
+	if dst["a"] != 1 || dst["b"] != 2 {
+		t.Errorf("DeepMerge() = %v, want the keys of both maps", dst)
+	}
+}
+
+func TestDeepMerge_SharedSlices(t *testing.T) {
+	type pair struct{ A, B []int }
+	shared := []int{1}
+	dst := pair{A: shared, B: shared}
+	src := []int{2}
+
+	if err := DeepMerge(&dst, pair{A: src, B: src}, MergeOptions{Slices: MergeSlicesAppend}); err != nil {
+		t.Fatalf("DeepMerge() returned %v", err)
+	}
+	if !reflect.DeepEqual(dst, pair{A: []int{1, 2}, B: []int{1, 2}}) || &dst.A[0] != &dst.B[0] {
+		t.Errorf("DeepMerge() = %v, want both slices merged and shared", dst)
+	}
+}
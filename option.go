@@ -0,0 +1,160 @@
+package godash
+
+import "fmt"
+
+// Option represents an optional value: it either holds a value (Some) or it doesn't (None).
+// It is an alternative to the (T, bool) pairs returned by functions like [Find] and [Pop] that can be chained.
+// The zero value of Option is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// OptionSome creates an Option holding the given value.
+// It isn't named Some because that name is taken by the [Some] predicate function.
+func OptionSome[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// OptionNone creates an Option holding no value.
+func OptionNone[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// NewOption creates an Option from a (T, bool) pair, as returned by functions like [Find]:
+// it holds value if ok is true, and is None otherwise.
+func NewOption[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return OptionNone[T]()
+	}
+	return OptionSome(value)
+}
+
+// IsSome checks whether the Option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone checks whether the Option holds no value.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value of the Option and true, or the zero value of type `T` and false if it is None.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the value of the Option. It panics if the Option is None.
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic("godash: Unwrap called on a None Option")
+	}
+	return o.value
+}
+
+// UnwrapOr returns the value of the Option, or defaultValue if it is None.
+func (o Option[T]) UnwrapOr(defaultValue T) T {
+	if !o.ok {
+		return defaultValue
+	}
+	return o.value
+}
+
+// OrElse returns the Option itself if it holds a value, or the Option returned by f otherwise.
+// f is only called if the Option is None.
+func (o Option[T]) OrElse(f func() Option[T]) Option[T] {
+	if !o.ok {
+		return f()
+	}
+	return o
+}
+
+// Map returns an Option holding the result of applying the mapper to the value, or None if the Option is None.
+// Use [OptionMap] to map the value to a different type.
+func (o Option[T]) Map(mapper MustMapper[T, T]) Option[T] {
+	return OptionMap(o, mapper)
+}
+
+// String returns "Some(value)" or "None". The value is converted to a string using the format "%v".
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// OptionMap returns an Option holding the result of applying the mapper to the value of o, or None if o is None.
+func OptionMap[T any, R any](o Option[T], mapper MustMapper[T, R]) Option[R] {
+	if !o.ok {
+		return OptionNone[R]()
+	}
+	return OptionSome(mapper(o.value))
+}
+
+// AtOpt behaves like [At], except it returns an Option that is None when the index is out of bounds,
+// instead of panicking.
+func AtOpt[T any, S ~[]T](s S, index int) Option[T] {
+	if index < 0 {
+		index += len(s)
+	}
+	if index < 0 || index >= len(s) {
+		return OptionNone[T]()
+	}
+	return OptionSome(s[index])
+}
+
+// AtOpt behaves exactly like [AtOpt] function, except it is called directly on the slice.
+func (s Slice[T]) AtOpt(index int) Option[T] {
+	return AtOpt(s, index)
+}
+
+// FindOpt behaves like [Find], except it returns an Option instead of a (T, bool) pair.
+func FindOpt[T any, S ~[]T](s S, p Predicate[T]) Option[T] {
+	return NewOption(Find(s, p))
+}
+
+// FindOpt behaves exactly like [FindOpt] function, except it is called directly on the slice.
+func (s Slice[T]) FindOpt(p Predicate[T]) Option[T] {
+	return FindOpt(s, p)
+}
+
+// FindLastOpt behaves like [FindLast], except it returns an Option instead of a (T, bool) pair.
+func FindLastOpt[T any, S ~[]T](s S, p Predicate[T]) Option[T] {
+	return NewOption(FindLast(s, p))
+}
+
+// FindLastOpt behaves exactly like [FindLastOpt] function, except it is called directly on the slice.
+func (s Slice[T]) FindLastOpt(p Predicate[T]) Option[T] {
+	return FindLastOpt(s, p)
+}
+
+// PopOpt behaves like [Pop], except it returns an Option instead of a (T, bool) pair.
+func PopOpt[T any, S ~*[]T](s S) Option[T] {
+	return NewOption(Pop(s))
+}
+
+// PopOpt behaves exactly like [PopOpt] function, except it is called directly on the slice.
+func (s *Slice[T]) PopOpt() Option[T] {
+	return NewOption(s.Pop())
+}
+
+// ShiftOpt behaves like [Shift], except it returns an Option instead of a (T, bool) pair.
+func ShiftOpt[T any, S ~*[]T](s S) Option[T] {
+	return NewOption(Shift(s))
+}
+
+// ShiftOpt behaves exactly like [ShiftOpt] function, except it is called directly on the slice.
+func (s *Slice[T]) ShiftOpt() Option[T] {
+	return NewOption(s.Shift())
+}
+
+// IndexOfOpt behaves like [IndexOf], except it returns an Option instead of an (int, bool) pair.
+func IndexOfOpt[T comparable](s ComparableSlice[T], value T) Option[int] {
+	return NewOption(IndexOf(s, value))
+}
+
+// IndexOfOpt behaves exactly like [IndexOfOpt] function, except it is called directly on the slice.
+func (s ComparableSlice[T]) IndexOfOpt(value T) Option[int] {
+	return IndexOfOpt(s, value)
+}
@@ -0,0 +1,31 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+	"strconv"
+	"strings"
+)
+
+func ExampleFindOpt() {
+	names := []string{"ana", "bob", "carl"}
+	startsWith := func(prefix string) godash.Predicate[string] {
+		return func(s string) bool { return strings.HasPrefix(s, prefix) }
+	}
+
+	fmt.Println(godash.FindOpt(names, startsWith("b")).Map(strings.ToUpper))
+	fmt.Println(godash.FindOpt(names, startsWith("z")).UnwrapOr("nobody"))
+	// Output:
+	// Some(BOB)
+	// nobody
+}
+
+func ExampleMapResults() {
+	for _, r := range godash.MapResults([]string{"1", "two", "3"}, strconv.Atoi) {
+		fmt.Println(r)
+	}
+	// Output:
+	// Ok(1)
+	// Err(strconv.Atoi: parsing "two": invalid syntax)
+	// Ok(3)
+}
@@ -0,0 +1,171 @@
+package godash
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestOption(t *testing.T) {
+	tests := []struct {
+		name         string
+		option       Option[int]
+		wantSome     bool
+		wantValue    int
+		wantUnwrapOr int
+		wantString   string
+	}{
+		{name: "some", option: OptionSome(3), wantSome: true, wantValue: 3, wantUnwrapOr: 3, wantString: "Some(3)"},
+		{name: "some zero value", option: OptionSome(0), wantSome: true, wantValue: 0, wantUnwrapOr: 0, wantString: "Some(0)"},
+		{name: "none", option: OptionNone[int](), wantSome: false, wantUnwrapOr: -1, wantString: "None"},
+		{name: "zero value", option: Option[int]{}, wantSome: false, wantUnwrapOr: -1, wantString: "None"},
+		{name: "from a found pair", option: NewOption(5, true), wantSome: true, wantValue: 5, wantUnwrapOr: 5, wantString: "Some(5)"},
+		{name: "from a not found pair", option: NewOption(5, false), wantSome: false, wantUnwrapOr: -1, wantString: "None"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.option.IsSome(); got != tt.wantSome {
+				t.Errorf("IsSome() = %t, want %t", got, tt.wantSome)
+			}
+			if got := tt.option.IsNone(); got == tt.wantSome {
+				t.Errorf("IsNone() = %t, want %t", got, !tt.wantSome)
+			}
+			if got, ok := tt.option.Get(); got != tt.wantValue || ok != tt.wantSome {
+				t.Errorf("Get() = %d, %t, want %d, %t", got, ok, tt.wantValue, tt.wantSome)
+			}
+			if got := tt.option.UnwrapOr(-1); got != tt.wantUnwrapOr {
+				t.Errorf("UnwrapOr(-1) = %d, want %d", got, tt.wantUnwrapOr)
+			}
+			if got := tt.option.String(); got != tt.wantString {
+				t.Errorf("String() = %q, want %q", got, tt.wantString)
+			}
+		})
+	}
+}
+
+func TestOption_Unwrap(t *testing.T) {
+	if got := OptionSome("a").Unwrap(); got != "a" {
+		t.Errorf("Unwrap() = %q, want %q", got, "a")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Unwrap() on None didn't panic")
+		}
+	}()
+	OptionNone[string]().Unwrap()
+}
+
+func TestOption_OrElse(t *testing.T) {
+	calls := 0
+	fallback := func() Option[int] {
+		calls++
+		return OptionSome(10)
+	}
+
+	if got := OptionSome(1).OrElse(fallback); got != OptionSome(1) || calls != 0 {
+		t.Errorf("OrElse() = %v with %d calls, want Some(1) with 0 calls", got, calls)
+	}
+	if got := OptionNone[int]().OrElse(fallback); got != OptionSome(10) || calls != 1 {
+		t.Errorf("OrElse() = %v with %d calls, want Some(10) with 1 call", got, calls)
+	}
+}
+
+func TestOption_Map(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	if got := OptionSome(2).Map(double); got != OptionSome(4) {
+		t.Errorf("Map() = %v, want Some(4)", got)
+	}
+	if got := OptionNone[int]().Map(double); got != OptionNone[int]() {
+		t.Errorf("Map() = %v, want None", got)
+	}
+
+	if got := OptionMap(OptionSome(2), strconv.Itoa); got != OptionSome("2") {
+		t.Errorf("OptionMap() = %v, want Some(2)", got)
+	}
+	if got := OptionMap(OptionNone[int](), strconv.Itoa); got != OptionNone[string]() {
+		t.Errorf("OptionMap() = %v, want None", got)
+	}
+}
+
+func TestAtOpt(t *testing.T) {
+	s := NewSlice("a", "b", "c")
+	tests := []struct {
+		index int
+		want  Option[string]
+	}{
+		{index: 0, want: OptionSome("a")},
+		{index: 2, want: OptionSome("c")},
+		{index: -1, want: OptionSome("c")},
+		{index: -3, want: OptionSome("a")},
+		{index: 3, want: OptionNone[string]()},
+		{index: -4, want: OptionNone[string]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.index), func(t *testing.T) {
+			if got := AtOpt(s, tt.index); got != tt.want {
+				t.Errorf("AtOpt(%d) = %v, want %v", tt.index, got, tt.want)
+			}
+			if got := s.AtOpt(tt.index); got != tt.want {
+				t.Errorf("s.AtOpt(%d) = %v, want %v", tt.index, got, tt.want)
+			}
+		})
+	}
+
+	if got := AtOpt([]int{}, 0); got.IsSome() {
+		t.Errorf("AtOpt([], 0) = %v, want None", got)
+	}
+}
+
+func TestLookupOpts(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	isNegative := func(i int) bool { return i < 0 }
+	s := NewSlice(1, 2, 3, 4, 5)
+
+	if got := FindOpt(s, isEven); got != OptionSome(2) {
+		t.Errorf("FindOpt() = %v, want Some(2)", got)
+	}
+	if got := s.FindOpt(isNegative); got.IsSome() {
+		t.Errorf("s.FindOpt() = %v, want None", got)
+	}
+	if got := FindLastOpt(s, isEven); got != OptionSome(4) {
+		t.Errorf("FindLastOpt() = %v, want Some(4)", got)
+	}
+	if got := s.FindLastOpt(isNegative); got.IsSome() {
+		t.Errorf("s.FindLastOpt() = %v, want None", got)
+	}
+
+	cs := NewComparableSlice("a", "b")
+	if got := IndexOfOpt(cs, "b"); got != OptionSome(1) {
+		t.Errorf("IndexOfOpt() = %v, want Some(1)", got)
+	}
+	if got := cs.IndexOfOpt("z"); got.IsSome() {
+		t.Errorf("cs.IndexOfOpt() = %v, want None", got)
+	}
+}
+
+func TestPopAndShiftOpt(t *testing.T) {
+	raw := []int{1, 2, 3}
+	if got := PopOpt(&raw); got != OptionSome(3) || len(raw) != 2 {
+		t.Errorf("PopOpt() = %v leaving %v, want Some(3) leaving [1 2]", got, raw)
+	}
+	if got := ShiftOpt(&raw); got != OptionSome(1) || len(raw) != 1 {
+		t.Errorf("ShiftOpt() = %v leaving %v, want Some(1) leaving [2]", got, raw)
+	}
+
+	s := NewSlice(7)
+	if got := s.PopOpt(); got != OptionSome(7) {
+		t.Errorf("s.PopOpt() = %v, want Some(7)", got)
+	}
+	if got := s.PopOpt(); got.IsSome() {
+		t.Errorf("s.PopOpt() = %v, want None", got)
+	}
+	if got := s.ShiftOpt(); got.IsSome() {
+		t.Errorf("s.ShiftOpt() = %v, want None", got)
+	}
+	s.Push(8)
+	if got := s.ShiftOpt(); got != OptionSome(8) {
+		t.Errorf("s.ShiftOpt() = %v, want Some(8)", got)
+	}
+}
@@ -0,0 +1,535 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPath is returned when a path can't be parsed.
+	ErrInvalidPath = errors.New("invalid path")
+	// ErrPathNotFound is returned when a segment of a path refers to a missing map key, an index out of range,
+	// an unknown struct field or a nil value.
+	ErrPathNotFound = errors.New("path not found")
+	// ErrPathTypeMismatch is returned when a segment of a path can't be applied to the value it reaches, or when
+	// the value at the end of a path doesn't have the expected type.
+	ErrPathTypeMismatch = errors.New("path type mismatch")
+)
+
+// PathError reports the segment of a path that failed. Its Err wraps [ErrInvalidPath], [ErrPathNotFound] or
+// [ErrPathTypeMismatch].
+type PathError struct {
+	Path    string
+	Segment string // empty if the error isn't about a single segment
+	Err     error
+}
+
+// Error describes the failed segment and the reason it failed.
+func (e *PathError) Error() string {
+	if e.Segment == "" {
+		return fmt.Sprintf("path %q: %v", e.Path, e.Err)
+	}
+	return fmt.Sprintf("path %q at segment %q: %v", e.Path, e.Segment, e.Err)
+}
+
+// Unwrap returns the reason the path failed.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// The path functions below navigate nested maps, slices, arrays, structs and pointers, such as the values produced
+// by decoding JSON into a map[string]any, using lodash-like paths such as "users[0].address.city":
+//
+//   - keys are separated by dots and refer to map keys or struct fields. A struct field is found by its name,
+//     or by the name in its json tag;
+//   - indexes are written in brackets and refer to elements of slices and arrays. Negative indexes count backward
+//     from the end, like in [At]. Keys made of digits can also be used as indexes, as in "users.0";
+//   - a backslash escapes the next character, so "a\.b" is the key "a.b" and "a\[0]" is the key "a[0]".
+//
+// The empty path refers to the root itself.
+
+// pathSegment is a key or an index of a parsed path.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// String returns the segment as written in a path.
+func (s pathSegment) String() string {
+	if s.isIndex {
+		return fmt.Sprintf("[%d]", s.index)
+	}
+	return s.key
+}
+
+// parsePath splits a path in its segments.
+func parsePath(path string) ([]pathSegment, error) {
+	segments := make([]pathSegment, 0)
+	invalid := func(format string, args ...any) error {
+		return &PathError{Path: path, Err: fmt.Errorf("%w: "+format, append([]any{ErrInvalidPath}, args...)...)}
+	}
+
+	for i := 0; i < len(path); {
+		switch {
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, invalid("unclosed bracket at position %d", i)
+			}
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil {
+				return nil, invalid("index %q at position %d isn't an integer", path[i+1:i+end], i)
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			i += end + 1
+		case path[i] == '.' && len(segments) == 0:
+			return nil, invalid("unexpected dot at position %d", i)
+		default:
+			if path[i] == '.' {
+				i++
+			}
+			var key strings.Builder
+			for ; i < len(path) && path[i] != '.' && path[i] != '['; i++ {
+				if path[i] == '\\' {
+					i++
+					if i == len(path) {
+						return nil, invalid("trailing backslash")
+					}
+				}
+				key.WriteByte(path[i])
+			}
+			if key.Len() == 0 {
+				return nil, invalid("empty key at position %d", i)
+			}
+			segments = append(segments, pathSegment{key: key.String()})
+		}
+	}
+	return segments, nil
+}
+
+// GetPath returns the value found by following the path from the root.
+// It returns a *[PathError] if the path is invalid, if a segment can't be followed or if the value found
+// isn't of type T. Nil values, such as JSON nulls, are returned as the zero value of T if it can hold nil.
+func GetPath[T any](root any, path string) (T, error) {
+	var zero T
+	v, err := lookupPath(root, path)
+	if err != nil {
+		return zero, err
+	}
+
+	if !v.IsValid() {
+		if isNillable(reflect.TypeFor[T]().Kind()) {
+			return zero, nil
+		}
+		return zero, &PathError{Path: path, Err: fmt.Errorf("%w: found nil, want %v", ErrPathTypeMismatch, reflect.TypeFor[T]())}
+	}
+	if !v.CanInterface() {
+		return zero, &PathError{Path: path, Err: fmt.Errorf("%w: the value is unexported", ErrPathTypeMismatch)}
+	}
+	result, ok := v.Interface().(T)
+	if !ok {
+		return zero, &PathError{Path: path, Err: fmt.Errorf("%w: found %v, want %v", ErrPathTypeMismatch, v.Type(), reflect.TypeFor[T]())}
+	}
+	return result, nil
+}
+
+// HasPath checks whether the path is valid and can be followed from the root up to its last segment.
+func HasPath(root any, path string) bool {
+	_, err := lookupPath(root, path)
+	return err == nil
+}
+
+// lookupPath follows the path from the root. It returns an invalid reflect.Value if the path leads to a nil value.
+func lookupPath(root any, path string) (reflect.Value, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	v := reflect.ValueOf(root)
+	for _, segment := range segments {
+		v = indirect(v)
+		if !v.IsValid() {
+			return v, &PathError{Path: path, Segment: segment.String(), Err: fmt.Errorf("%w: nil value", ErrPathNotFound)}
+		}
+		if v, err = child(v, segment); err != nil {
+			return v, &PathError{Path: path, Segment: segment.String(), Err: err}
+		}
+	}
+	return indirectInterface(v), nil
+}
+
+// indirect follows pointers and interfaces until it reaches a concrete value, returning an invalid reflect.Value
+// if it finds a nil one.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// indirectInterface returns the value held by an interface, or an invalid reflect.Value if it is nil.
+func indirectInterface(v reflect.Value) reflect.Value {
+	if v.IsValid() && v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		return v.Elem()
+	}
+	return v
+}
+
+// child returns the element of a map, slice, array or struct referred to by the segment.
+func child(v reflect.Value, segment pathSegment) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Map:
+		key, err := mapKey(v.Type().Key(), segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		element := v.MapIndex(key)
+		if !element.IsValid() {
+			return element, fmt.Errorf("%w: missing key", ErrPathNotFound)
+		}
+		return element, nil
+	case reflect.Slice, reflect.Array:
+		index, err := sliceIndex(v.Len(), segment, false)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return v.Index(index), nil
+	case reflect.Struct:
+		field, ok := structField(v.Type(), segment)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%w: %v has no field %s", ErrPathNotFound, v.Type(), segment)
+		}
+		element, err := v.FieldByIndexErr(field.Index)
+		if err != nil {
+			return element, fmt.Errorf("%w: %v", ErrPathNotFound, err)
+		}
+		return element, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("%w: can't look into a %v", ErrPathTypeMismatch, v.Type())
+	}
+}
+
+// mapKey converts a segment to a key of the given type. String keys accept any segment, and integer keys accept
+// segments made of digits.
+func mapKey(keyType reflect.Type, segment pathSegment) (reflect.Value, error) {
+	text := segment.key
+	if segment.isIndex {
+		text = strconv.Itoa(segment.index)
+	}
+
+	key := reflect.New(keyType).Elem()
+	switch keyType.Kind() {
+	case reflect.String:
+		key.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, keyType.Bits())
+		if err != nil {
+			return key, fmt.Errorf("%w: %q isn't a valid %v key", ErrPathTypeMismatch, text, keyType)
+		}
+		key.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, keyType.Bits())
+		if err != nil {
+			return key, fmt.Errorf("%w: %q isn't a valid %v key", ErrPathTypeMismatch, text, keyType)
+		}
+		key.SetUint(u)
+	default:
+		return key, fmt.Errorf("%w: maps with %v keys aren't supported", ErrPathTypeMismatch, keyType)
+	}
+	return key, nil
+}
+
+// sliceIndex converts a segment to an index in [0, length), counting negative indexes backward from the end.
+// If appending is true, length itself is also accepted.
+func sliceIndex(length int, segment pathSegment, appending bool) (int, error) {
+	index := segment.index
+	if !segment.isIndex {
+		var err error
+		if index, err = strconv.Atoi(segment.key); err != nil {
+			return 0, fmt.Errorf("%w: %q isn't an index", ErrPathTypeMismatch, segment.key)
+		}
+	}
+
+	original := index
+	if index < 0 {
+		index += length
+	}
+	if index < 0 || index > length || (index == length && !appending) {
+		return 0, fmt.Errorf("%w: index %d out of range for length %d", ErrPathNotFound, original, length)
+	}
+	return index, nil
+}
+
+// structField returns the exported field of the struct type named by the segment, either by its name or by
+// the name in its json tag.
+func structField(t reflect.Type, segment pathSegment) (reflect.StructField, bool) {
+	if segment.isIndex {
+		return reflect.StructField{}, false
+	}
+	if field, ok := t.FieldByName(segment.key); ok && field.IsExported() {
+		return field, true
+	}
+	for _, field := range reflect.VisibleFields(t) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.IsExported() && name == segment.key {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// SetPath sets the value found by following the path from the root, which must be a non-nil pointer or map.
+// Missing map keys and nil pointers along the path are created, as are nil maps. Missing values held by
+// interfaces, as in a map[string]any, are created as a []any if the next segment is an index or as a
+// map[string]any otherwise. An index equal to the length of a slice appends the value to it.
+// It returns a *[PathError] if the path is invalid, if a segment can't be followed or if the value isn't
+// assignable to the type at the end of the path.
+func SetPath(root any, path string, value any) error {
+	return updatePath(root, path, pathUpdate{value: reflect.ValueOf(value)})
+}
+
+// UnsetPath removes the value found by following the path from the root, which must be a non-nil pointer or map.
+// Map keys are deleted and slice elements are removed, shifting the following ones, while struct fields and
+// array elements are set to their zero value. Nothing happens if the path doesn't lead to a value.
+// It returns a *[PathError] if the path is invalid or if a segment can't be applied to the value it reaches.
+func UnsetPath(root any, path string) error {
+	return updatePath(root, path, pathUpdate{unset: true})
+}
+
+// pathUpdate is the change made by SetPath or UnsetPath at the end of a path.
+type pathUpdate struct {
+	value reflect.Value
+	unset bool
+}
+
+// updatePath applies the update at the end of the path.
+func updatePath(root any, path string, update pathUpdate) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(root)
+	if !v.IsValid() || (v.Kind() != reflect.Pointer && v.Kind() != reflect.Map) || v.IsNil() {
+		return &PathError{Path: path, Err: fmt.Errorf("%w: the root must be a non-nil pointer or map", ErrPathTypeMismatch)}
+	}
+	if len(segments) == 0 && v.Kind() == reflect.Map {
+		return &PathError{Path: path, Err: fmt.Errorf("%w: a map root can't be replaced", ErrPathTypeMismatch)}
+	}
+
+	updater := pathUpdater{path: path, segments: segments, update: update}
+	if v.Kind() == reflect.Map {
+		_, err = updater.apply(v, v.Type(), 0)
+		return err
+	}
+	updated, err := updater.apply(v.Elem(), v.Elem().Type(), 0)
+	if err == nil {
+		v.Elem().Set(updated)
+	}
+	return err
+}
+
+// pathUpdater applies an update at the end of a path. Since map elements and the values held by interfaces can't
+// be changed in place, every step returns the updated value, to be stored back by the previous one.
+type pathUpdater struct {
+	path     string
+	segments []pathSegment
+	update   pathUpdate
+}
+
+// fail wraps the error in a PathError for the segment at position i.
+func (u pathUpdater) fail(i int, err error) error {
+	return &PathError{Path: u.path, Segment: u.segments[i].String(), Err: err}
+}
+
+// apply returns the value v, of type t, updated by following the path from the segment at position i.
+// An invalid v stands for a missing value.
+func (u pathUpdater) apply(v reflect.Value, t reflect.Type, i int) (reflect.Value, error) {
+	if i == len(u.segments) {
+		return u.assign(t, i)
+	}
+
+	missing := !v.IsValid() || (isNillable(t.Kind()) && v.IsNil())
+	if missing && u.update.unset {
+		return v, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		var inner reflect.Value
+		if missing {
+			inner = reflect.ValueOf(map[string]any{})
+			if u.segments[i].isIndex {
+				inner = reflect.ValueOf([]any{})
+			}
+		} else {
+			inner = v.Elem()
+		}
+		updated, err := u.apply(inner, inner.Type(), i)
+		if err != nil {
+			return v, err
+		}
+		result := reflect.New(t).Elem()
+		result.Set(updated)
+		return result, nil
+	case reflect.Pointer:
+		if missing {
+			v = reflect.New(t.Elem())
+		}
+		updated, err := u.apply(v.Elem(), t.Elem(), i)
+		if err == nil {
+			v.Elem().Set(updated)
+		}
+		return v, err
+	case reflect.Map:
+		if missing {
+			v = reflect.MakeMap(t)
+		}
+		return v, u.applyToMap(v, t, i)
+	case reflect.Slice:
+		return u.applyToSlice(v, t, i)
+	case reflect.Array, reflect.Struct:
+		copied := reflect.New(t).Elem()
+		if v.IsValid() {
+			copied.Set(v)
+		}
+		return copied, u.applyToElement(copied, t, i)
+	default:
+		return v, u.fail(i, fmt.Errorf("%w: can't look into a %v", ErrPathTypeMismatch, t))
+	}
+}
+
+// applyToMap updates the element of the map referred to by the segment at position i.
+func (u pathUpdater) applyToMap(m reflect.Value, t reflect.Type, i int) error {
+	key, err := mapKey(t.Key(), u.segments[i])
+	if err != nil {
+		return u.fail(i, err)
+	}
+	if u.update.unset && i == len(u.segments)-1 {
+		m.SetMapIndex(key, reflect.Value{})
+		return nil
+	}
+
+	element := m.MapIndex(key)
+	if !element.IsValid() && u.update.unset {
+		return nil
+	}
+	updated, err := u.apply(element, t.Elem(), i+1)
+	if err != nil {
+		return err
+	}
+	m.SetMapIndex(key, updated)
+	return nil
+}
+
+// applyToSlice returns the slice with the element referred to by the segment at position i updated.
+func (u pathUpdater) applyToSlice(s reflect.Value, t reflect.Type, i int) (reflect.Value, error) {
+	if !s.IsValid() {
+		s = reflect.MakeSlice(t, 0, 0)
+	}
+	last := i == len(u.segments)-1
+	index, err := sliceIndex(s.Len(), u.segments[i], !u.update.unset)
+	if err != nil {
+		if u.update.unset && errors.Is(err, ErrPathNotFound) {
+			return s, nil
+		}
+		return s, u.fail(i, err)
+	}
+
+	if u.update.unset && last {
+		return reflect.AppendSlice(s.Slice(0, index), s.Slice(index+1, s.Len())), nil
+	}
+	if index == s.Len() {
+		s = reflect.Append(s, reflect.Zero(t.Elem()))
+		updated, err := u.apply(reflect.Value{}, t.Elem(), i+1)
+		if err == nil {
+			s.Index(index).Set(updated)
+		}
+		return s, err
+	}
+	updated, err := u.apply(s.Index(index), t.Elem(), i+1)
+	if err == nil {
+		s.Index(index).Set(updated)
+	}
+	return s, err
+}
+
+// applyToElement updates the element of an addressable array or struct referred to by the segment at position i.
+func (u pathUpdater) applyToElement(v reflect.Value, t reflect.Type, i int) error {
+	var element reflect.Value
+	if t.Kind() == reflect.Array {
+		index, err := sliceIndex(v.Len(), u.segments[i], false)
+		if err != nil {
+			if u.update.unset && errors.Is(err, ErrPathNotFound) {
+				return nil
+			}
+			return u.fail(i, err)
+		}
+		element = v.Index(index)
+	} else {
+		field, ok := structField(t, u.segments[i])
+		if !ok {
+			return u.fail(i, fmt.Errorf("%w: %v has no field %s", ErrPathNotFound, t, u.segments[i]))
+		}
+		var err error
+		if element, err = v.FieldByIndexErr(field.Index); err != nil {
+			return u.fail(i, fmt.Errorf("%w: %v", ErrPathNotFound, err))
+		}
+	}
+
+	if u.update.unset && i == len(u.segments)-1 {
+		element.SetZero()
+		return nil
+	}
+	updated, err := u.apply(element, element.Type(), i+1)
+	if err == nil {
+		element.Set(updated)
+	}
+	return err
+}
+
+// assign returns the new value converted to the type t, at the end of the path.
+func (u pathUpdater) assign(t reflect.Type, i int) (reflect.Value, error) {
+	value := u.update.value
+	if !value.IsValid() {
+		if isNillable(t.Kind()) {
+			return reflect.Zero(t), nil
+		}
+		return value, u.failLast(i, fmt.Errorf("%w: can't assign nil to %v", ErrPathTypeMismatch, t))
+	}
+	if !value.Type().AssignableTo(t) {
+		return value, u.failLast(i, fmt.Errorf("%w: can't assign %v to %v", ErrPathTypeMismatch, value.Type(), t))
+	}
+	result := reflect.New(t).Elem()
+	result.Set(value)
+	return result, nil
+}
+
+// failLast wraps the error in a PathError for the last segment before position i, if any.
+func (u pathUpdater) failLast(i int, err error) error {
+	if i == 0 {
+		return &PathError{Path: u.path, Err: err}
+	}
+	return u.fail(i-1, err)
+}
+
+// isNillable checks whether values of the kind can be nil.
+func isNillable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,30 @@
+package godash_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleGetPath() {
+	var payload any
+	_ = json.Unmarshal([]byte(`{"orders": [{"id": 1, "items": [{"sku": "a-1"}, {"sku": "b-2"}]}]}`), &payload)
+
+	fmt.Println(godash.GetPath[string](payload, "orders[0].items[-1].sku"))
+	fmt.Println(godash.GetPath[string](payload, "orders[0].items[2].sku"))
+	// Output:
+	// b-2 <nil>
+	//  path "orders[0].items[2].sku" at segment "[2]": path not found: index 2 out of range for length 2
+}
+
+func ExampleSetPath() {
+	payload := map[string]any{}
+	_ = godash.SetPath(payload, "user.name", "ana")
+	_ = godash.SetPath(payload, "user.roles[0]", "admin")
+	_ = godash.UnsetPath(payload, "user.name")
+
+	encoded, _ := json.Marshal(payload)
+	fmt.Println(string(encoded), godash.HasPath(payload, "user.roles[0]"))
+	// Output:
+	// {"user":{"roles":["admin"]}} true
+}
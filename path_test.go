@@ -0,0 +1,264 @@
+package godash
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type pathAddress struct {
+	City    string `json:"city"`
+	ZipCode string `json:"zip_code,omitempty"`
+}
+
+type pathUser struct {
+	Name      string
+	Tags      []string
+	Address   *pathAddress `json:"address"`
+	Scores    [3]int
+	Metadata  map[string]any
+	private   string
+	Favorites map[int]string
+}
+
+// decodedJSON returns a value like the ones produced by decoding JSON into an any.
+func decodedJSON(t *testing.T) map[string]any {
+	t.Helper()
+	var payload map[string]any
+	err := json.Unmarshal([]byte(`{
+		"users": [
+			{"name": "ana", "roles": ["admin", "dev"], "address": {"city": "Recife"}},
+			{"name": "bob", "roles": [], "address": null}
+		],
+		"a.b": {"[0]": "escaped"},
+		"count": 2
+	}`), &payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func TestGetPath(t *testing.T) {
+	payload := decodedJSON(t)
+	user := &pathUser{
+		Name:      "carla",
+		Tags:      []string{"x", "y"},
+		Address:   &pathAddress{City: "Porto"},
+		Scores:    [3]int{7, 8, 9},
+		Metadata:  map[string]any{"nested": []any{map[string]any{"ok": true}}},
+		private:   "hidden",
+		Favorites: map[int]string{3: "three"},
+	}
+
+	tests := []struct {
+		name string
+		root any
+		path string
+		want any
+		get  func(root any, path string) (any, error)
+	}{
+		{"dotted keys and indexes", payload, "users[0].address.city", "Recife", getAs[string]},
+		{"negative index", payload, "users[-1].name", "bob", getAs[string]},
+		{"digits as index", payload, "users.1.name", "bob", getAs[string]},
+		{"escaped segments", payload, `a\.b.\[0]`, "escaped", getAs[string]},
+		{"JSON numbers", payload, "count", 2.0, getAs[float64]},
+		{"JSON null", payload, "users[1].address", map[string]any(nil), getAs[map[string]any]},
+		{"empty path", 42, "", 42, getAs[int]},
+		{"struct fields through pointers", user, "Address.City", "Porto", getAs[string]},
+		{"json tag names", user, "address.city", "Porto", getAs[string]},
+		{"arrays", user, "Scores[-3]", 7, getAs[int]},
+		{"nested maps and slices", user, "Metadata.nested[0].ok", true, getAs[bool]},
+		{"integer map keys", user, "Favorites[3]", "three", getAs[string]},
+		{"slices", user, "Tags", []string{"x", "y"}, getAs[[]string]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.get(tt.root, tt.path)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPath(%q) = %v, %v, want %v, nil", tt.path, got, err, tt.want)
+			}
+			if !HasPath(tt.root, tt.path) {
+				t.Errorf("HasPath(%q) = false, want true", tt.path)
+			}
+		})
+	}
+}
+
+func getAs[T any](root any, path string) (any, error) {
+	return GetPath[T](root, path)
+}
+
+func TestGetPath_Errors(t *testing.T) {
+	payload := decodedJSON(t)
+	user := pathUser{Address: nil, private: "hidden"}
+
+	tests := []struct {
+		name    string
+		root    any
+		path    string
+		wantErr error
+		message string
+	}{
+		{"missing key", payload, "users[0].email", ErrPathNotFound,
+			`path "users[0].email" at segment "email": path not found: missing key`},
+		{"index out of range", payload, "users[2].name", ErrPathNotFound,
+			`path "users[2].name" at segment "[2]": path not found: index 2 out of range for length 2`},
+		{"negative index out of range", payload, "users[-3]", ErrPathNotFound,
+			`path "users[-3]" at segment "[-3]": path not found: index -3 out of range for length 2`},
+		{"through null", payload, "users[1].address.city", ErrPathNotFound,
+			`path "users[1].address.city" at segment "city": path not found: nil value`},
+		{"into a scalar", payload, "count.value", ErrPathTypeMismatch,
+			`path "count.value" at segment "value": path type mismatch: can't look into a float64`},
+		{"key on a slice", payload, "users.first", ErrPathTypeMismatch,
+			`path "users.first" at segment "first": path type mismatch: "first" isn't an index`},
+		{"wrong type", payload, "count", ErrPathTypeMismatch,
+			`path "count": path type mismatch: found float64, want string`},
+		{"unexported field", user, "private", ErrPathNotFound,
+			`path "private" at segment "private": path not found: godash.pathUser has no field private`},
+		{"nil pointer", user, "Address.City", ErrPathNotFound,
+			`path "Address.City" at segment "City": path not found: nil value`},
+		{"invalid index", payload, "users[first]", ErrInvalidPath,
+			`path "users[first]": invalid path: index "first" at position 5 isn't an integer`},
+		{"unclosed bracket", payload, "users[0", ErrInvalidPath,
+			`path "users[0": invalid path: unclosed bracket at position 5`},
+		{"empty key", payload, "users..name", ErrInvalidPath,
+			`path "users..name": invalid path: empty key at position 6`},
+		{"leading dot", payload, ".users", ErrInvalidPath,
+			`path ".users": invalid path: unexpected dot at position 0`},
+		{"trailing backslash", payload, `users\`, ErrInvalidPath,
+			`path "users\\": invalid path: trailing backslash`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GetPath[string](tt.root, tt.path)
+			var pathErr *PathError
+			if !errors.As(err, &pathErr) || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetPath(%q) returned %v, want a *PathError wrapping %v", tt.path, err, tt.wantErr)
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.message)
+			}
+			// the path of a value with the wrong type exists
+			if exists := tt.name == "wrong type"; HasPath(tt.root, tt.path) != exists {
+				t.Errorf("HasPath(%q) = %v, want %v", tt.path, !exists, exists)
+			}
+		})
+	}
+}
+
+func TestSetPath(t *testing.T) {
+	t.Run("decoded JSON", func(t *testing.T) {
+		payload := decodedJSON(t)
+		steps := []struct {
+			path  string
+			value any
+		}{
+			{"users[0].address.city", "Olinda"},
+			{"users[-1].roles[0]", "guest"},
+			{"users[1].address.zip", "50000"},
+			{"settings.theme.colors[0]", "blue"},
+			{"count", nil},
+		}
+		for _, step := range steps {
+			if err := SetPath(payload, step.path, step.value); err != nil {
+				t.Fatalf("SetPath(%q) returned %v", step.path, err)
+			}
+		}
+
+		got, _ := json.Marshal(payload)
+		want := `{"a.b":{"[0]":"escaped"},"count":null,"settings":{"theme":{"colors":["blue"]}},"users":[` +
+			`{"address":{"city":"Olinda"},"name":"ana","roles":["admin","dev"]},` +
+			`{"address":{"zip":"50000"},"name":"bob","roles":["guest"]}]}`
+		if string(got) != want {
+			t.Errorf("payload = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("structs", func(t *testing.T) {
+		user := pathUser{Tags: []string{"a"}}
+		steps := []struct {
+			path  string
+			value any
+		}{
+			{"Name", "dani"},
+			{"address.zip_code", "123"},
+			{"Tags[1]", "b"},
+			{"Scores[-1]", 10},
+			{"Metadata.level", 3},
+			{"Favorites.7", "seven"},
+		}
+		for _, step := range steps {
+			if err := SetPath(&user, step.path, step.value); err != nil {
+				t.Fatalf("SetPath(%q) returned %v", step.path, err)
+			}
+		}
+
+		want := pathUser{
+			Name:      "dani",
+			Tags:      []string{"a", "b"},
+			Address:   &pathAddress{ZipCode: "123"},
+			Scores:    [3]int{0, 0, 10},
+			Metadata:  map[string]any{"level": 3},
+			Favorites: map[int]string{7: "seven"},
+		}
+		if !reflect.DeepEqual(user, want) {
+			t.Errorf("user = %+v, want %+v", user, want)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		user := pathUser{Tags: []string{"a"}}
+		tests := []struct {
+			root    any
+			path    string
+			value   any
+			message string
+		}{
+			{&user, "Name", 1, `path "Name" at segment "Name": path type mismatch: can't assign int to string`},
+			{&user, "Scores", nil, `path "Scores" at segment "Scores": path type mismatch: can't assign nil to [3]int`},
+			{&user, "Tags[3]", "d", `path "Tags[3]" at segment "[3]": path not found: index 3 out of range for length 1`},
+			{&user, "Email", "x", `path "Email" at segment "Email": path not found: godash.pathUser has no field Email`},
+			{&user, "Name.first", "x", `path "Name.first" at segment "first": path type mismatch: can't look into a string`},
+			{user, "Name", "x", `path "Name": path type mismatch: the root must be a non-nil pointer or map`},
+			{map[string]any{}, "", "x", `path "": path type mismatch: a map root can't be replaced`},
+		}
+		for _, tt := range tests {
+			if err := SetPath(tt.root, tt.path, tt.value); err == nil || err.Error() != tt.message {
+				t.Errorf("SetPath(%q) returned %v, want %q", tt.path, err, tt.message)
+			}
+		}
+	})
+}
+
+func TestUnsetPath(t *testing.T) {
+	payload := decodedJSON(t)
+	for _, path := range []string{"users[0].roles[0]", "users[-1].address", "a\\.b", "count", "missing.key", "users[5].name"} {
+		if err := UnsetPath(payload, path); err != nil {
+			t.Fatalf("UnsetPath(%q) returned %v", path, err)
+		}
+	}
+	got, _ := json.Marshal(payload)
+	want := `{"users":[{"address":{"city":"Recife"},"name":"ana","roles":["dev"]},{"name":"bob","roles":[]}]}`
+	if string(got) != want {
+		t.Errorf("payload = %s, want %s", got, want)
+	}
+
+	user := pathUser{Name: "eva", Tags: []string{"a", "b", "c"}, Scores: [3]int{1, 2, 3}}
+	for _, path := range []string{"Name", "Tags[1]", "Scores[0]", "Address.City"} {
+		if err := UnsetPath(&user, path); err != nil {
+			t.Fatalf("UnsetPath(%q) returned %v", path, err)
+		}
+	}
+	if got := fmt.Sprintf("%q %v %v", user.Name, user.Tags, user.Scores); got != `"" [a c] [0 2 3]` {
+		t.Errorf("user = %s, want \"\" [a c] [0 2 3]", got)
+	}
+
+	if err := UnsetPath(&user, "Name.first"); !errors.Is(err, ErrPathTypeMismatch) {
+		t.Errorf("UnsetPath() returned %v, want %v", err, ErrPathTypeMismatch)
+	}
+}
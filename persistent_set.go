@@ -0,0 +1,412 @@
+package godash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"math/bits"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// PersistentSet is an immutable set: updating it returns a new version and leaves the old one unchanged, so
+// versions can be shared freely, including across goroutines. It is a hash array mapped trie, whose versions share
+// most of their nodes, so updates take O(log32 n) time.
+//
+// The zero value is an empty set. Use [PersistentSet.Transient] to make many updates efficiently.
+type PersistentSet[T comparable] struct {
+	root  *hamtNode[T]
+	count int
+}
+
+// hamtNode is a node of the trie of a PersistentSet. Each node dispatches on 5 bits of the hashes of the elements,
+// and only holds the entries of the slots in use, marked in its bitmap.
+type hamtNode[T comparable] struct {
+	bitmap  uint32
+	entries []hamtEntry[T]
+	owner   *transientOwner
+}
+
+// hamtEntry is either a child node or a leaf holding the elements with the same hash, usually a single one.
+type hamtEntry[T comparable] struct {
+	node   *hamtNode[T]
+	hash   uint64
+	values []T
+}
+
+// NewPersistentSet creates a PersistentSet with the specified elements, such as the elements of a Slice.
+func NewPersistentSet[T comparable](elements ...T) PersistentSet[T] {
+	transient := PersistentSet[T]{}.Transient()
+	transient.Add(elements...)
+	return transient.Persistent()
+}
+
+// PersistentSetFromSet creates a PersistentSet with the elements of a Set.
+func PersistentSetFromSet[T comparable](s Set[T]) PersistentSet[T] {
+	transient := PersistentSet[T]{}.Transient()
+	for element := range s {
+		transient.Add(element)
+	}
+	return transient.Persistent()
+}
+
+// Len returns the number of elements of the set.
+func (s PersistentSet[T]) Len() int {
+	return s.count
+}
+
+// Has checks whether the element is in the set.
+func (s PersistentSet[T]) Has(element T) bool {
+	return s.root.has(hashOf(element), 0, element)
+}
+
+// Add returns a version of the set with the elements added.
+func (s PersistentSet[T]) Add(elements ...T) PersistentSet[T] {
+	for _, element := range elements {
+		s.add(element, nil)
+	}
+	return s
+}
+
+// Delete returns a version of the set without the elements.
+func (s PersistentSet[T]) Delete(elements ...T) PersistentSet[T] {
+	for _, element := range elements {
+		s.delete(element, nil)
+	}
+	return s
+}
+
+// Values returns a sequence of the elements of the set, in no particular order.
+func (s PersistentSet[T]) Values() Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.each(yield)
+	}
+}
+
+// ToSlice returns the elements of the set in a new Slice, in no particular order.
+func (s PersistentSet[T]) ToSlice() Slice[T] {
+	return Collect(s.Values())
+}
+
+// ToSet returns the elements of the set in a new Set.
+func (s PersistentSet[T]) ToSet() Set[T] {
+	result := make(Set[T], s.count)
+	s.root.each(func(element T) bool {
+		result.Add(element)
+		return true
+	})
+	return result
+}
+
+// String returns a string representation of the set, listing its elements in no particular order.
+func (s PersistentSet[T]) String() string {
+	elements := MustMap(s.ToSlice(), func(element T) string {
+		return fmt.Sprint(element)
+	})
+	return "PersistentSet{" + strings.Join(elements, ", ") + "}"
+}
+
+// Transient returns a TransientSet holding the elements of the set, to make many updates in place before getting
+// a new version with [TransientSet.Persistent]. The set itself isn't changed.
+func (s PersistentSet[T]) Transient() *TransientSet[T] {
+	return &TransientSet[T]{set: s, owner: &transientOwner{}}
+}
+
+// TransientSet is a mutable version of a [PersistentSet], created by [PersistentSet.Transient], for batched
+// updates: the nodes it creates are changed in place by later updates instead of being copied. It must not be
+// used after [TransientSet.Persistent] is called, nor by several goroutines at once.
+type TransientSet[T comparable] struct {
+	set   PersistentSet[T]
+	owner *transientOwner
+}
+
+// Len returns the number of elements of the set.
+func (t *TransientSet[T]) Len() int {
+	t.ensureOwned()
+	return t.set.count
+}
+
+// Has checks whether the element is in the set.
+func (t *TransientSet[T]) Has(element T) bool {
+	t.ensureOwned()
+	return t.set.Has(element)
+}
+
+// Add adds the elements to the set.
+func (t *TransientSet[T]) Add(elements ...T) {
+	t.ensureOwned()
+	for _, element := range elements {
+		t.set.add(element, t.owner)
+	}
+}
+
+// Delete removes the elements from the set.
+func (t *TransientSet[T]) Delete(elements ...T) {
+	t.ensureOwned()
+	for _, element := range elements {
+		t.set.delete(element, t.owner)
+	}
+}
+
+// Persistent returns a PersistentSet holding the elements of the transient set, which can't be used anymore.
+func (t *TransientSet[T]) Persistent() PersistentSet[T] {
+	t.ensureOwned()
+	t.owner = nil
+	return t.set
+}
+
+// ensureOwned panics if the transient set was already turned into a persistent one.
+func (t *TransientSet[T]) ensureOwned() {
+	if t.owner == nil {
+		panic("godash: TransientSet used after Persistent")
+	}
+}
+
+func (s *PersistentSet[T]) add(element T, owner *transientOwner) {
+	root := s.root
+	if root == nil {
+		root = &hamtNode[T]{owner: owner}
+	}
+	if root, added := root.add(hashOf(element), 0, element, owner); added {
+		s.root = root
+		s.count++
+	}
+}
+
+func (s *PersistentSet[T]) delete(element T, owner *transientOwner) {
+	if s.root == nil {
+		return
+	}
+	if root, deleted := s.root.delete(hashOf(element), 0, element, owner); deleted {
+		s.root = root
+		s.count--
+	}
+}
+
+// slot returns the bit of the slot of the hash in the node, and the index of its entry if the slot is in use.
+func (n *hamtNode[T]) slot(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[T]) has(hash uint64, shift uint, element T) bool {
+	for n != nil {
+		bit, i := n.slot(hash, shift)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+		entry := n.entries[i]
+		if entry.node == nil {
+			return entry.hash == hash && slices.Contains(entry.values, element)
+		}
+		n, shift = entry.node, shift+hamtBits
+	}
+	return false
+}
+
+// add returns a version of the node with the element added, or the node itself and false if it has the element.
+func (n *hamtNode[T]) add(hash uint64, shift uint, element T, owner *transientOwner) (*hamtNode[T], bool) {
+	bit, i := n.slot(hash, shift)
+	if n.bitmap&bit == 0 {
+		edited := n.editable(owner)
+		edited.entries = slices.Insert(edited.entries, i, hamtEntry[T]{hash: hash, values: []T{element}})
+		edited.bitmap |= bit
+		return edited, true
+	}
+
+	entry := n.entries[i]
+	switch {
+	case entry.node != nil:
+		child, added := entry.node.add(hash, shift+hamtBits, element, owner)
+		if !added {
+			return n, false
+		}
+		entry.node = child
+	case entry.hash == hash:
+		if slices.Contains(entry.values, element) {
+			return n, false
+		}
+		entry.values = append(slices.Clip(entry.values), element)
+	default:
+		entry = hamtEntry[T]{node: newHAMTPair(entry, hamtEntry[T]{hash: hash, values: []T{element}}, shift+hamtBits, owner)}
+	}
+	edited := n.editable(owner)
+	edited.entries[i] = entry
+	return edited, true
+}
+
+// newHAMTPair returns a node holding two leaves with different hashes, nested as deep as needed to tell them apart.
+func newHAMTPair[T comparable](a, b hamtEntry[T], shift uint, owner *transientOwner) *hamtNode[T] {
+	slotA, slotB := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+	if slotA == slotB {
+		child := newHAMTPair(a, b, shift+hamtBits, owner)
+		return &hamtNode[T]{bitmap: 1 << slotA, entries: []hamtEntry[T]{{node: child}}, owner: owner}
+	}
+	if slotA > slotB {
+		a, b = b, a
+	}
+	return &hamtNode[T]{bitmap: 1<<slotA | 1<<slotB, entries: []hamtEntry[T]{a, b}, owner: owner}
+}
+
+// delete returns a version of the node without the element, or the node itself and false if it doesn't have it.
+// Child nodes left with a single leaf are replaced by the leaf, so the trie stays as shallow as possible.
+func (n *hamtNode[T]) delete(hash uint64, shift uint, element T, owner *transientOwner) (*hamtNode[T], bool) {
+	bit, i := n.slot(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	entry := n.entries[i]
+	if entry.node != nil {
+		child, deleted := entry.node.delete(hash, shift+hamtBits, element, owner)
+		if !deleted {
+			return n, false
+		}
+		edited := n.editable(owner)
+		if len(child.entries) == 1 && child.entries[0].node == nil {
+			edited.entries[i] = child.entries[0]
+		} else {
+			edited.entries[i].node = child
+		}
+		return edited, true
+	}
+
+	j := slices.Index(entry.values, element)
+	if entry.hash != hash || j < 0 {
+		return n, false
+	}
+	edited := n.editable(owner)
+	if len(entry.values) == 1 {
+		edited.entries = slices.Delete(edited.entries, i, i+1)
+		edited.bitmap &^= bit
+	} else {
+		edited.entries[i].values = slices.Delete(slices.Clone(entry.values), j, j+1)
+	}
+	return edited, true
+}
+
+// each calls yield for every element held by the node and its children, stopping if yield returns false.
+func (n *hamtNode[T]) each(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	for _, entry := range n.entries {
+		if entry.node != nil {
+			if !entry.node.each(yield) {
+				return false
+			}
+			continue
+		}
+		for _, value := range entry.values {
+			if !yield(value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// editable returns the node itself if it is owned by the transient owner, or a copy owned by it otherwise.
+func (n *hamtNode[T]) editable(owner *transientOwner) *hamtNode[T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	return &hamtNode[T]{bitmap: n.bitmap, entries: slices.Clone(n.entries), owner: owner}
+}
+
+// hashSeed is the seed of the hashes of the elements of every PersistentSet.
+var hashSeed = maphash.MakeSeed()
+
+// hashOf returns the hash of a comparable value, such that equal values have equal hashes, like the hashes of
+// the keys of maps. Common types are hashed directly, and other types are hashed through reflection.
+func hashOf[T comparable](value T) uint64 {
+	switch v := any(value).(type) {
+	case string:
+		return maphash.String(hashSeed, v)
+	case int:
+		return hashUint64(uint64(v))
+	case int64:
+		return hashUint64(uint64(v))
+	case int32:
+		return hashUint64(uint64(v))
+	case uint:
+		return hashUint64(uint64(v))
+	case uint64:
+		return hashUint64(v)
+	case uint32:
+		return hashUint64(uint64(v))
+	}
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	writeHash(&h, reflect.ValueOf(&value).Elem())
+	return h.Sum64()
+}
+
+// hashUint64 returns the hash of an integer.
+func hashUint64(v uint64) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return maphash.Bytes(hashSeed, buf[:])
+}
+
+// writeHash writes the parts of a comparable value that equal values have in common to the hash.
+func writeHash(h *maphash.Hash, v reflect.Value) {
+	writeUint64 := func(u uint64) {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], u)
+		_, _ = h.Write(buf[:])
+	}
+	writeFloat := func(f float64) {
+		if f == 0 {
+			f = 0 // -0 equals +0
+		}
+		writeUint64(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint64(1)
+		} else {
+			writeUint64(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(real(v.Complex()))
+		writeFloat(imag(v.Complex()))
+	case reflect.String:
+		_, _ = h.WriteString(v.String())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name != "_" {
+				writeHash(h, v.Field(i))
+			}
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			writeUint64(0)
+			return
+		}
+		_, _ = h.WriteString(v.Elem().Type().String())
+		writeHash(h, v.Elem())
+	default:
+		// Pointers, channels and unsafe pointers are equal when they have the same address.
+		writeUint64(uint64(v.Pointer()))
+	}
+}
@@ -0,0 +1,17 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExamplePersistentSet() {
+	s1 := godash.NewPersistentSet("go", "rust")
+	s2 := s1.Add("zig").Delete("rust")
+
+	fmt.Println(s1.Has("rust"), s2.Has("rust"), s2.Has("zig"), s2.Len())
+	fmt.Println(s2.ToSet())
+	// Output:
+	// true false true 2
+	// set{go, zig}
+}
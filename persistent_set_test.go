@@ -0,0 +1,151 @@
+package godash
+
+import (
+	"maps"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// checkSet fails the test if the set doesn't hold the expected elements.
+func checkSet[T comparable](t *testing.T, s PersistentSet[T], want Set[T]) {
+	t.Helper()
+	if s.Len() != want.Size() {
+		t.Fatalf("Len() = %d, want %d", s.Len(), want.Size())
+	}
+	for element := range want {
+		if !s.Has(element) {
+			t.Fatalf("Has(%v) = false, want true", element)
+		}
+	}
+	if got := s.ToSet(); !maps.Equal(got, want) {
+		t.Fatalf("ToSet() = %v, want %v", got, want)
+	}
+}
+
+func TestPersistentSet(t *testing.T) {
+	var empty PersistentSet[string]
+	checkSet(t, empty, NewSet[string]())
+	checkSet(t, empty.Delete("a"), NewSet[string]())
+
+	s := NewPersistentSet("a", "b", "a")
+	checkSet(t, s, NewSet("a", "b"))
+	checkSet(t, s.Add("c").Delete("a", "z"), NewSet("b", "c"))
+	checkSet(t, s, NewSet("a", "b"))
+	checkSet(t, PersistentSetFromSet(NewSet(1, 2, 3)), NewSet(1, 2, 3))
+
+	large := NewPersistentSet(Range(0, 100000, 1)...)
+	checkSet(t, large, NewSet(Range(0, 100000, 1)...))
+	checkSet(t, large.Delete(Range(0, 100000, 2)...), NewSet(Range(1, 100000, 2)...))
+	if large.Has(100000) {
+		t.Error("Has(100000) = true, want false")
+	}
+}
+
+func TestPersistentSet_Hashing(t *testing.T) {
+	type point struct {
+		X, Y float64
+		name any
+	}
+	s := NewPersistentSet(point{0, 1, "a"}, point{math.Copysign(0, -1), 1, "a"}, point{0, 1, 1})
+	checkSet(t, s, NewSet(point{0, 1, "a"}, point{0, 1, 1}))
+
+	a, b := new(int), new(int)
+	pointers := NewPersistentSet(a, b, a)
+	checkSet(t, pointers, NewSet(a, b))
+
+	arrays := NewPersistentSet([2]bool{true, false}, [2]bool{false, true}, [2]bool{true, false})
+	checkSet(t, arrays, NewSet([2]bool{true, false}, [2]bool{false, true}))
+}
+
+func TestPersistentSet_Collisions(t *testing.T) {
+	// Hashes are forced to collide fully, or to differ only in the last bits used by the trie.
+	hashes := map[string]uint64{"a": 7, "b": 7, "c": 7, "d": 7 | 1<<63, "e": 8}
+	root := &hamtNode[string]{}
+	versions := []*hamtNode[string]{root}
+	for _, element := range []string{"a", "b", "c", "d", "e"} {
+		root, _ = root.add(hashes[element], 0, element, nil)
+		versions = append(versions, root)
+	}
+	if _, added := root.add(hashes["b"], 0, "b", nil); added {
+		t.Error("add() added an element already in the set")
+	}
+	for element, hash := range hashes {
+		if !root.has(hash, 0, element) {
+			t.Errorf("has(%q) = false, want true", element)
+		}
+	}
+
+	for _, element := range []string{"b", "d", "a", "c", "e"} {
+		var deleted bool
+		root, deleted = root.delete(hashes[element], 0, element, nil)
+		if !deleted || root.has(hashes[element], 0, element) {
+			t.Fatalf("delete(%q) didn't delete it", element)
+		}
+	}
+	if len(root.entries) != 0 || root.bitmap != 0 {
+		t.Errorf("root = %+v, want an empty node", root)
+	}
+	if got := Collect(PersistentSet[string]{root: versions[4], count: 4}.Values()); len(got) != 4 {
+		t.Errorf("an older version lost elements: %v", got)
+	}
+}
+
+func TestPersistentSet_Versions(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	versions := []PersistentSet[int]{{}}
+	models := []Set[int]{NewSet[int]()}
+
+	for step := 0; step < 3000; step++ {
+		k := r.IntN(len(versions))
+		s, model := versions[k], maps.Clone(models[k])
+		switch op := r.IntN(3); op {
+		case 0:
+			element := r.IntN(500)
+			s = s.Add(element)
+			model.Add(element)
+		case 1:
+			element := r.IntN(500)
+			s = s.Delete(element)
+			model.Delete(element)
+		default:
+			transient := s.Transient()
+			for i := 0; i < 50; i++ {
+				element := r.IntN(500)
+				if r.IntN(2) == 0 {
+					transient.Add(element)
+					model.Add(element)
+				} else {
+					transient.Delete(element)
+					model.Delete(element)
+				}
+				if transient.Has(element) != model.Has(element) || transient.Len() != model.Size() {
+					t.Fatalf("the transient set differs from %v", model)
+				}
+			}
+			s = transient.Persistent()
+		}
+		versions, models = append(versions, s), append(models, model)
+	}
+
+	for i, s := range versions {
+		checkSet(t, s, models[i])
+	}
+}
+
+func TestTransientSet(t *testing.T) {
+	transient := NewPersistentSet(1).Transient()
+	transient.Persistent()
+	defer func() {
+		if recover() == nil {
+			t.Error("using a transient after Persistent() didn't panic")
+		}
+	}()
+	transient.Add(2)
+}
+
+func TestPersistentSet_String(t *testing.T) {
+	if got := NewPersistentSet("a").String(); got != "PersistentSet{a}" {
+		t.Errorf("String() = %q, want %q", got, "PersistentSet{a}")
+	}
+}
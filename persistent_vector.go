@@ -0,0 +1,377 @@
+package godash
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// transientOwner marks the nodes of a persistent data structure created by a transient, which can be changed
+// in place by that transient because no persistent version shares them yet. It isn't empty, so every owner
+// has a distinct address.
+type transientOwner struct {
+	_ byte
+}
+
+// PersistentVector is an immutable sequence: updating it returns a new version and leaves the old one unchanged,
+// so versions can be shared freely, including across goroutines. Versions share most of their structure, a
+// 32-way trie whose last leaf is kept apart as a tail, as in Clojure's vectors, so updates take O(log32 n) time
+// and appends take amortized constant time.
+//
+// The zero value is an empty vector. Use [PersistentVector.Transient] to make many updates efficiently.
+type PersistentVector[T any] struct {
+	trie vectorTrie[T]
+}
+
+// vectorTrie holds the elements of a PersistentVector or of a TransientVector. The elements are kept in the
+// leaves of the trie, in order, except for the last ones, which are kept in the tail until it is full.
+type vectorTrie[T any] struct {
+	count int
+	shift uint // of the children of the root, a multiple of vectorBits
+	root  *vectorNode[T]
+	tail  []T
+}
+
+// vectorNode is a node of a vectorTrie: a leaf holding values or a branch holding children.
+type vectorNode[T any] struct {
+	children []*vectorNode[T]
+	values   []T
+	owner    *transientOwner
+}
+
+// NewPersistentVector creates a PersistentVector with the specified elements, such as the elements of a Slice.
+func NewPersistentVector[T any](elements ...T) PersistentVector[T] {
+	transient := PersistentVector[T]{}.Transient()
+	transient.Append(elements...)
+	return transient.Persistent()
+}
+
+// Len returns the number of elements of the vector.
+func (v PersistentVector[T]) Len() int {
+	return v.trie.count
+}
+
+// Get returns the element at the index, or false if the index is out of range.
+func (v PersistentVector[T]) Get(index int) (T, bool) {
+	return v.trie.get(index)
+}
+
+// Last returns the last element of the vector, or false if it is empty.
+func (v PersistentVector[T]) Last() (T, bool) {
+	return v.trie.get(v.trie.count - 1)
+}
+
+// Set returns a version of the vector with the element at the index replaced by value.
+// It panics if the index is out of range.
+func (v PersistentVector[T]) Set(index int, value T) PersistentVector[T] {
+	v.trie.set(index, value, nil)
+	return v
+}
+
+// Append returns a version of the vector with the values added at its end.
+func (v PersistentVector[T]) Append(values ...T) PersistentVector[T] {
+	if len(values) > vectorWidth {
+		transient := v.Transient()
+		transient.Append(values...)
+		return transient.Persistent()
+	}
+	for _, value := range values {
+		v.trie.append(value, nil)
+	}
+	return v
+}
+
+// Pop returns a version of the vector without its last element. If the vector is empty, it is returned as is.
+func (v PersistentVector[T]) Pop() PersistentVector[T] {
+	if v.trie.count > 0 {
+		v.trie.pop(nil)
+	}
+	return v
+}
+
+// Values returns a sequence of the elements of the vector, in order.
+func (v PersistentVector[T]) Values() Seq[T] {
+	return v.trie.values()
+}
+
+// ToSlice returns the elements of the vector in a new Slice.
+func (v PersistentVector[T]) ToSlice() Slice[T] {
+	result := make(Slice[T], 0, v.trie.count)
+	v.trie.values()(func(value T) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+// String returns the elements of the vector formatted like a slice.
+func (v PersistentVector[T]) String() string {
+	return fmt.Sprint(v.ToSlice())
+}
+
+// Transient returns a TransientVector holding the elements of the vector, to make many updates in place before
+// getting a new version with [TransientVector.Persistent]. The vector itself isn't changed.
+func (v PersistentVector[T]) Transient() *TransientVector[T] {
+	trie := v.trie
+	trie.tail = append(make([]T, 0, vectorWidth), trie.tail...)
+	return &TransientVector[T]{trie: trie, owner: &transientOwner{}}
+}
+
+// TransientVector is a mutable version of a [PersistentVector], created by [PersistentVector.Transient], for
+// batched updates: the nodes it creates are changed in place by later updates instead of being copied. It must
+// not be used after [TransientVector.Persistent] is called, nor by several goroutines at once.
+type TransientVector[T any] struct {
+	trie  vectorTrie[T]
+	owner *transientOwner
+}
+
+// Len returns the number of elements of the vector.
+func (t *TransientVector[T]) Len() int {
+	t.ensureOwned()
+	return t.trie.count
+}
+
+// Get returns the element at the index, or false if the index is out of range.
+func (t *TransientVector[T]) Get(index int) (T, bool) {
+	t.ensureOwned()
+	return t.trie.get(index)
+}
+
+// Set replaces the element at the index with value. It panics if the index is out of range.
+func (t *TransientVector[T]) Set(index int, value T) {
+	t.ensureOwned()
+	t.trie.set(index, value, t.owner)
+}
+
+// Append adds the values at the end of the vector.
+func (t *TransientVector[T]) Append(values ...T) {
+	t.ensureOwned()
+	for _, value := range values {
+		t.trie.append(value, t.owner)
+	}
+}
+
+// Pop removes the last element of the vector and returns it, or returns false if the vector is empty.
+func (t *TransientVector[T]) Pop() (T, bool) {
+	t.ensureOwned()
+	last, ok := t.trie.get(t.trie.count - 1)
+	if ok {
+		t.trie.pop(t.owner)
+	}
+	return last, ok
+}
+
+// Persistent returns a PersistentVector holding the elements of the transient vector, which can't be used anymore.
+func (t *TransientVector[T]) Persistent() PersistentVector[T] {
+	t.ensureOwned()
+	t.owner = nil
+	trie := t.trie
+	trie.tail = slices.Clip(trie.tail)
+	return PersistentVector[T]{trie: trie}
+}
+
+// ensureOwned panics if the transient vector was already turned into a persistent one.
+func (t *TransientVector[T]) ensureOwned() {
+	if t.owner == nil {
+		panic("godash: TransientVector used after Persistent")
+	}
+}
+
+// tailOffset returns the index of the first element of the tail.
+func (t *vectorTrie[T]) tailOffset() int {
+	if t.count < vectorWidth {
+		return 0
+	}
+	return ((t.count - 1) >> vectorBits) << vectorBits
+}
+
+// leafFor returns the values of the leaf, or of the tail, holding the element at the index.
+func (t *vectorTrie[T]) leafFor(index int) []T {
+	if index >= t.tailOffset() {
+		return t.tail
+	}
+	node := t.root
+	for level := t.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values
+}
+
+func (t *vectorTrie[T]) get(index int) (T, bool) {
+	if index < 0 || index >= t.count {
+		var zero T
+		return zero, false
+	}
+	return t.leafFor(index)[index&vectorMask], true
+}
+
+func (t *vectorTrie[T]) set(index int, value T, owner *transientOwner) {
+	if index < 0 || index >= t.count {
+		panic(fmt.Sprintf("godash: index %d out of range for a vector of length %d", index, t.count))
+	}
+	if index >= t.tailOffset() {
+		if owner == nil {
+			t.tail = slices.Clone(t.tail)
+		}
+		t.tail[index&vectorMask] = value
+		return
+	}
+	t.root = t.setInNode(t.shift, t.root, index, value, owner)
+}
+
+// setInNode returns a version of the node whose leaf holding the element at the index has the value.
+func (t *vectorTrie[T]) setInNode(level uint, node *vectorNode[T], index int, value T, owner *transientOwner) *vectorNode[T] {
+	edited := node.editable(owner)
+	if level == 0 {
+		edited.values[index&vectorMask] = value
+	} else {
+		i := (index >> level) & vectorMask
+		edited.children[i] = t.setInNode(level-vectorBits, node.children[i], index, value, owner)
+	}
+	return edited
+}
+
+func (t *vectorTrie[T]) append(value T, owner *transientOwner) {
+	if n := t.count - t.tailOffset(); n < vectorWidth {
+		if owner == nil {
+			t.tail = append(slices.Clip(t.tail), value)
+		} else {
+			t.tail = append(t.tail, value)
+		}
+		t.count++
+		return
+	}
+
+	// The tail is full: it becomes the last leaf of the trie, which grows a level when the root is full.
+	leaf := &vectorNode[T]{values: t.tail, owner: owner}
+	switch {
+	case t.root == nil:
+		t.root, t.shift = &vectorNode[T]{children: []*vectorNode[T]{leaf}, owner: owner}, vectorBits
+	case t.count>>vectorBits > 1<<t.shift:
+		path := newVectorPath(t.shift, leaf, owner)
+		t.root = &vectorNode[T]{children: []*vectorNode[T]{t.root, path}, owner: owner}
+		t.shift += vectorBits
+	default:
+		t.root = t.pushLeaf(t.shift, t.root, leaf, owner)
+	}
+	if owner == nil {
+		t.tail = []T{value}
+	} else {
+		t.tail = append(make([]T, 0, vectorWidth), value)
+	}
+	t.count++
+}
+
+// pushLeaf returns a version of the node with the leaf added after its last leaf.
+func (t *vectorTrie[T]) pushLeaf(level uint, node *vectorNode[T], leaf *vectorNode[T], owner *transientOwner) *vectorNode[T] {
+	edited := node.editable(owner)
+	i := ((t.count - 1) >> level) & vectorMask
+	var child *vectorNode[T]
+	switch {
+	case level == vectorBits:
+		child = leaf
+	case i < len(node.children):
+		child = t.pushLeaf(level-vectorBits, node.children[i], leaf, owner)
+	default:
+		child = newVectorPath(level-vectorBits, leaf, owner)
+	}
+	if i < len(edited.children) {
+		edited.children[i] = child
+	} else {
+		edited.children = append(edited.children, child)
+	}
+	return edited
+}
+
+// newVectorPath returns a branch of single children leading to the leaf from the given level.
+func newVectorPath[T any](level uint, leaf *vectorNode[T], owner *transientOwner) *vectorNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newVectorPath(level-vectorBits, leaf, owner)}, owner: owner}
+}
+
+func (t *vectorTrie[T]) pop(owner *transientOwner) {
+	switch {
+	case t.count == 1:
+		*t = vectorTrie[T]{}
+		if owner != nil {
+			t.tail = make([]T, 0, vectorWidth)
+		}
+		return
+	case t.count-t.tailOffset() > 1:
+		if owner == nil {
+			t.tail = slices.Clone(t.tail[:len(t.tail)-1])
+		} else {
+			var zero T
+			t.tail[len(t.tail)-1] = zero
+			t.tail = t.tail[:len(t.tail)-1]
+		}
+		t.count--
+		return
+	}
+
+	// The tail has a single element: the last leaf of the trie becomes the tail, and the trie loses a level
+	// when its root is left with a single child.
+	tail := t.leafFor(t.count - 2)
+	if owner != nil {
+		tail = append(make([]T, 0, vectorWidth), tail...)
+	}
+	root := t.popLeaf(t.shift, t.root, owner)
+	switch {
+	case root == nil:
+		t.root, t.shift = nil, 0
+	case t.shift > vectorBits && len(root.children) == 1:
+		t.root, t.shift = root.children[0], t.shift-vectorBits
+	default:
+		t.root = root
+	}
+	t.tail = tail
+	t.count--
+}
+
+// popLeaf returns a version of the node without its last leaf, or nil if it has no leaves left.
+func (t *vectorTrie[T]) popLeaf(level uint, node *vectorNode[T], owner *transientOwner) *vectorNode[T] {
+	i := ((t.count - 2) >> level) & vectorMask
+	var child *vectorNode[T]
+	if level > vectorBits {
+		child = t.popLeaf(level-vectorBits, node.children[i], owner)
+	}
+	if child == nil && i == 0 {
+		return nil
+	}
+
+	edited := node.editable(owner)
+	if child == nil {
+		edited.children[i] = nil
+		edited.children = edited.children[:i]
+	} else {
+		edited.children[i] = child
+	}
+	return edited
+}
+
+func (t *vectorTrie[T]) values() Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < t.count; i += vectorWidth {
+			for _, value := range t.leafFor(i) {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// editable returns the node itself if it is owned by the transient owner, or a copy owned by it otherwise.
+func (n *vectorNode[T]) editable(owner *transientOwner) *vectorNode[T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	return &vectorNode[T]{children: slices.Clone(n.children), values: slices.Clone(n.values), owner: owner}
+}
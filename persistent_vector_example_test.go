@@ -0,0 +1,28 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExamplePersistentVector() {
+	v1 := godash.NewPersistentVector("a", "b", "c")
+	v2 := v1.Set(0, "z").Append("d")
+	v3 := v2.Pop()
+
+	fmt.Println(v1, v2, v3)
+	// Output:
+	// [a b c] [z b c d] [z b c]
+}
+
+func ExamplePersistentVector_Transient() {
+	transient := godash.NewPersistentVector(1, 2).Transient()
+	for i := 3; i <= 5; i++ {
+		transient.Append(i * i)
+	}
+	transient.Set(0, 0)
+
+	fmt.Println(transient.Persistent().ToSlice())
+	// Output:
+	// [0 2 9 16 25]
+}
@@ -0,0 +1,158 @@
+package godash
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkVector fails the test if the vector doesn't hold the expected elements.
+func checkVector(t *testing.T, v PersistentVector[int], want []int) {
+	t.Helper()
+	if v.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", v.Len(), len(want))
+	}
+	if got := v.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+	for i, w := range want {
+		if got, ok := v.Get(i); !ok || got != w {
+			t.Fatalf("Get(%d) = %d, %t, want %d, true", i, got, ok, w)
+		}
+	}
+}
+
+func TestPersistentVector(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		var v PersistentVector[int]
+		checkVector(t, v, []int{})
+		checkVector(t, v.Pop(), []int{})
+		if _, ok := v.Last(); ok {
+			t.Error("Last() of an empty vector should be false")
+		}
+		checkVector(t, v.Append(1, 2), []int{1, 2})
+	})
+
+	// The sizes cross the boundaries of the tail and of the levels of the trie.
+	for _, n := range []int{1, 31, 32, 33, 64, 1056, 1057, 32*32*32 + 33} {
+		want := Range(0, n, 1)
+
+		appended := PersistentVector[int]{}
+		for _, v := range want {
+			appended = appended.Append(v)
+		}
+		checkVector(t, appended, want)
+		checkVector(t, NewPersistentVector(want...), want)
+
+		popped := appended
+		for i := n; i > 0; i-- {
+			if last, ok := popped.Last(); !ok || last != i-1 {
+				t.Fatalf("Last() of a vector of %d elements = %d, %t, want %d, true", i, last, ok, i-1)
+			}
+			popped = popped.Pop()
+			if i%1000 == 1 || i < 70 {
+				checkVector(t, popped, want[:i-1])
+			}
+		}
+		checkVector(t, appended, want)
+	}
+}
+
+func TestPersistentVector_Versions(t *testing.T) {
+	// Random updates are applied to random older versions, which must never change.
+	r := rand.New(rand.NewPCG(1, 2))
+	versions := []PersistentVector[int]{{}}
+	models := [][]int{{}}
+
+	for step := 0; step < 3000; step++ {
+		k := r.IntN(len(versions))
+		v, model := versions[k], slices.Clone(models[k])
+		switch op := r.IntN(10); {
+		case op < 5:
+			values := Range(step, step+r.IntN(40), 1)
+			v, model = v.Append(values...), append(model, values...)
+		case op < 7 && len(model) > 0:
+			v, model = v.Pop(), model[:len(model)-1]
+		case op < 9 && len(model) > 0:
+			i := r.IntN(len(model))
+			v, model[i] = v.Set(i, -step), -step
+		default:
+			transient := v.Transient()
+			for i := 0; i < 50; i++ {
+				if len(model) > 0 && r.IntN(3) == 0 {
+					popped, _ := transient.Pop()
+					if popped != model[len(model)-1] {
+						t.Fatalf("Pop() = %d, want %d", popped, model[len(model)-1])
+					}
+					model = model[:len(model)-1]
+				} else if len(model) > 0 && r.IntN(2) == 0 {
+					i := r.IntN(len(model))
+					transient.Set(i, step)
+					model[i] = step
+				} else {
+					transient.Append(step)
+					model = append(model, step)
+				}
+			}
+			v = transient.Persistent()
+		}
+		versions, models = append(versions, v), append(models, model)
+	}
+
+	for i, v := range versions {
+		if got := v.ToSlice(); !slices.Equal(got, models[i]) {
+			t.Fatalf("version %d = %v, want %v", i, got, models[i])
+		}
+	}
+}
+
+func TestPersistentVector_SetPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Set() with an index out of range didn't panic")
+		}
+	}()
+	NewPersistentVector(1, 2).Set(2, 3)
+}
+
+func TestTransientVector(t *testing.T) {
+	original := NewPersistentVector(Range(0, 100, 1)...)
+	transient := original.Transient()
+	transient.Set(0, -1)
+	transient.Set(99, -99)
+	transient.Append(100)
+	if popped, ok := transient.Pop(); !ok || popped != 100 {
+		t.Errorf("Pop() = %d, %t, want 100, true", popped, ok)
+	}
+	if got, _ := transient.Get(0); got != -1 || transient.Len() != 100 {
+		t.Errorf("Get(0) = %d and Len() = %d, want -1 and 100", got, transient.Len())
+	}
+
+	updated := transient.Persistent()
+	checkVector(t, original, Range(0, 100, 1))
+	if first, _ := updated.Get(0); first != -1 {
+		t.Errorf("Get(0) = %d, want -1", first)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("using a transient after Persistent() didn't panic")
+		}
+	}()
+	transient.Append(1)
+}
+
+func TestPersistentVector_Values(t *testing.T) {
+	v := NewPersistentVector(Range(0, 100, 1)...)
+	var got []int
+	v.Values()(func(value int) bool {
+		got = append(got, value)
+		return value < 40
+	})
+	if !slices.Equal(got, Range(0, 41, 1)) {
+		t.Errorf("Values() yielded %v, want the values up to 40", got)
+	}
+	if got := NewPersistentVector(1, 2, 3).String(); got != "[1 2 3]" {
+		t.Errorf("String() = %q, want %q", got, "[1 2 3]")
+	}
+}
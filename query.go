@@ -0,0 +1,360 @@
+package godash
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Comparator defines a function type that compares two values of type T, returning a negative number when a
+// comes before b, a positive number when a comes after b and zero when their order doesn't matter.
+// It follows the same convention as [cmp.Compare] and [slices.SortFunc].
+type Comparator[T any] func(a, b T) int
+
+// CompareBy returns a Comparator that orders values by the key returned by the key function.
+func CompareBy[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Query is a builder of SQL-like queries over in-memory data. Each method returns a new Query with one more
+// step, leaving the original unchanged, so a Query can be used as the base of several others.
+//
+// Queries are lazy: no step runs until the query is executed by [Query.ToSlice], [Query.Seq], [Query.Count]
+// or [Query.First], and a query runs again from its source every time it is executed. Most steps stream their
+// rows one at a time, so a [Query.Limit] stops reading the source as soon as it has enough rows. Sorting and
+// grouping need every row before producing their first one, which [Query.Explain] reports as "buffered".
+//
+// Steps that change the type of the rows or need comparable rows are free functions: [Select], [GroupBy],
+// [Distinct] and [DistinctBy].
+type Query[T any] struct {
+	source Seq[T]
+	order  []orderClause[T]
+	plan   []string
+}
+
+// orderClause is a comparator of an OrderBy or ThenBy step that hasn't been applied to the query source yet.
+type orderClause[T any] struct {
+	name       string
+	comparator Comparator[T]
+	descending bool
+}
+
+// NewQuery creates a Query whose rows are the elements of s.
+func NewQuery[T any, S ~[]T](s S) Query[T] {
+	return Query[T]{
+		source: Values(s),
+		plan:   []string{fmt.Sprintf("From: slice with %d rows", len(s))},
+	}
+}
+
+// Query creates a Query whose rows are the elements of the slice. It behaves exactly like [NewQuery].
+func (s Slice[T]) Query() Query[T] {
+	return NewQuery(s)
+}
+
+// NewQueryFromSeq creates a Query whose rows are the values of seq.
+func NewQueryFromSeq[T any](seq Seq[T]) Query[T] {
+	return Query[T]{
+		source: seq,
+		plan:   []string{"From: sequence"},
+	}
+}
+
+// then returns a copy of the query with the pending order clauses applied and a new step, described by name,
+// wrapping its source.
+func (q Query[T]) then(name string, step func(source Seq[T]) Seq[T]) Query[T] {
+	q = q.flushOrder()
+	return Query[T]{
+		source: step(q.source),
+		plan:   append(slices.Clip(q.plan), name),
+	}
+}
+
+// flushOrder returns a copy of the query whose source is sorted by its pending order clauses.
+func (q Query[T]) flushOrder() Query[T] {
+	if len(q.order) == 0 {
+		return q
+	}
+
+	clauses, source := q.order, q.source
+	names := MustMap(clauses, func(c orderClause[T]) string { return c.name })
+	return Query[T]{
+		source: func(yield func(T) bool) {
+			rows := Collect(source)
+			slices.SortStableFunc(rows, func(a, b T) int {
+				for _, clause := range clauses {
+					result := clause.comparator(a, b)
+					if clause.descending {
+						result = -result
+					}
+					if result != 0 {
+						return result
+					}
+				}
+				return 0
+			})
+			Values(rows)(yield)
+		},
+		plan: append(slices.Clip(q.plan), strings.Join(names, ", ")+" (buffered)"),
+	}
+}
+
+// Where keeps only the rows that satisfy the predicate.
+func (q Query[T]) Where(p Predicate[T]) Query[T] {
+	return q.then("Where", func(source Seq[T]) Seq[T] {
+		return func(yield func(T) bool) {
+			source(func(v T) bool {
+				return !p(v) || yield(v)
+			})
+		}
+	})
+}
+
+// Having keeps only the rows that satisfy the predicate. It behaves exactly like [Query.Where], and exists so
+// filters applied to the results of a [GroupBy] read like their SQL counterpart.
+func (q Query[T]) Having(p Predicate[T]) Query[T] {
+	filtered := q.Where(p)
+	filtered.plan[len(filtered.plan)-1] = "Having"
+	return filtered
+}
+
+// OrderBy sorts the rows in ascending order according to the comparator, replacing any previous ordering:
+// the OrderBy and ThenBy steps right before it are dropped without sorting the rows.
+// The sort is stable, so rows the comparator considers equal keep their relative order.
+// Use [CompareBy] to order rows by one of their fields.
+func (q Query[T]) OrderBy(c Comparator[T]) Query[T] {
+	q.order = []orderClause[T]{{name: "OrderBy", comparator: c}}
+	return q
+}
+
+// OrderByDescending behaves like [Query.OrderBy], except the rows are sorted in descending order.
+func (q Query[T]) OrderByDescending(c Comparator[T]) Query[T] {
+	q.order = []orderClause[T]{{name: "OrderByDescending", comparator: c, descending: true}}
+	return q
+}
+
+// ThenBy sorts the rows that the previous [Query.OrderBy] or ThenBy steps consider equal in ascending order
+// according to the comparator. If it doesn't follow one of those steps, it behaves like [Query.OrderBy].
+func (q Query[T]) ThenBy(c Comparator[T]) Query[T] {
+	if len(q.order) == 0 {
+		return q.OrderBy(c)
+	}
+	q.order = append(slices.Clip(q.order), orderClause[T]{name: "ThenBy", comparator: c})
+	return q
+}
+
+// ThenByDescending behaves like [Query.ThenBy], except the rows are sorted in descending order.
+func (q Query[T]) ThenByDescending(c Comparator[T]) Query[T] {
+	if len(q.order) == 0 {
+		return q.OrderByDescending(c)
+	}
+	q.order = append(slices.Clip(q.order), orderClause[T]{name: "ThenByDescending", comparator: c, descending: true})
+	return q
+}
+
+// Offset skips the first n rows. A negative n is treated as zero.
+func (q Query[T]) Offset(n int) Query[T] {
+	return q.then(fmt.Sprintf("Offset: %d", n), func(source Seq[T]) Seq[T] {
+		return func(yield func(T) bool) {
+			skipped := 0
+			source(func(v T) bool {
+				if skipped < n {
+					skipped++
+					return true
+				}
+				return yield(v)
+			})
+		}
+	})
+}
+
+// Limit keeps at most the first n rows, and stops reading the previous steps once it has them.
+// A negative n is treated as zero.
+func (q Query[T]) Limit(n int) Query[T] {
+	return q.then(fmt.Sprintf("Limit: %d", n), func(source Seq[T]) Seq[T] {
+		return func(yield func(T) bool) {
+			if n <= 0 {
+				return
+			}
+			taken := 0
+			source(func(v T) bool {
+				taken++
+				return yield(v) && taken < n
+			})
+		}
+	})
+}
+
+// Distinct removes duplicated rows, keeping the first occurrence of each one. Rows are compared with the ==
+// operator: use [DistinctBy] for rows that aren't comparable.
+func Distinct[T comparable](q Query[T]) Query[T] {
+	distinct := DistinctBy(q, func(v T) T { return v })
+	distinct.plan[len(distinct.plan)-1] = "Distinct"
+	return distinct
+}
+
+// DistinctBy removes rows whose key, returned by the key function, was already seen in a previous row.
+func DistinctBy[T any, K comparable](q Query[T], key func(T) K) Query[T] {
+	return q.then("DistinctBy", func(source Seq[T]) Seq[T] {
+		return func(yield func(T) bool) {
+			seen := NewSet[K]()
+			source(func(v T) bool {
+				k := key(v)
+				if seen.Has(k) {
+					return true
+				}
+				seen.Add(k)
+				return yield(v)
+			})
+		}
+	})
+}
+
+// Select transforms every row of the query with the mapper, creating a query over the new type.
+func Select[T any, R any](q Query[T], mapper MustMapper[T, R]) Query[R] {
+	q = q.flushOrder()
+	source := q.source
+	return Query[R]{
+		source: func(yield func(R) bool) {
+			source(func(v T) bool {
+				return yield(mapper(v))
+			})
+		},
+		plan: append(slices.Clip(q.plan), "Select"),
+	}
+}
+
+// Group is a set of rows sharing the same key, created by [GroupBy].
+type Group[K comparable, T any] struct {
+	Key   K
+	Items Slice[T]
+}
+
+// Count returns the number of rows in the group.
+func (g Group[K, T]) Count() int {
+	return len(g.Items)
+}
+
+// GroupBy groups the rows of the query by the key returned by the key function, creating a query over the groups.
+// The groups are yielded in the order their keys first appear, and the rows of each group keep their order.
+// Use [Group.Count], [SumBy], [AverageBy], [MinBy] and [MaxBy] to aggregate the rows of each group, and
+// [Query.Having] to filter the groups.
+func GroupBy[T any, K comparable](q Query[T], key func(T) K) Query[Group[K, T]] {
+	q = q.flushOrder()
+	source := q.source
+	return Query[Group[K, T]]{
+		source: func(yield func(Group[K, T]) bool) {
+			indexes := make(map[K]int)
+			groups := make([]Group[K, T], 0)
+			source(func(v T) bool {
+				k := key(v)
+				i, ok := indexes[k]
+				if !ok {
+					i = len(groups)
+					indexes[k] = i
+					groups = append(groups, Group[K, T]{Key: k})
+				}
+				groups[i].Items = append(groups[i].Items, v)
+				return true
+			})
+			Values(groups)(yield)
+		},
+		plan: append(slices.Clip(q.plan), "GroupBy (buffered)"),
+	}
+}
+
+// Seq returns a Seq over the rows of the query, running every step lazily as the rows are consumed.
+func (q Query[T]) Seq() Seq[T] {
+	return q.flushOrder().source
+}
+
+// ToSlice runs the query and returns its rows.
+func (q Query[T]) ToSlice() Slice[T] {
+	return Collect(q.Seq())
+}
+
+// Count runs the query and returns the number of rows.
+func (q Query[T]) Count() int {
+	count := 0
+	q.Seq()(func(T) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// First runs the query until its first row and returns it.
+// If the query has no rows, it returns the zero value of type `T` and `false`.
+func (q Query[T]) First() (T, bool) {
+	var first T
+	found := false
+	q.Seq()(func(v T) bool {
+		first, found = v, true
+		return false
+	})
+	return first, found
+}
+
+// Explain returns a description of the steps of the query, one per line and in the order they run.
+// Steps that need every row before producing the first one are marked as "buffered".
+func (q Query[T]) Explain() string {
+	plan := q.flushOrder().plan
+	lines := make([]string, len(plan))
+	for i, step := range plan {
+		lines[i] = fmt.Sprintf("%d. %s", i+1, step)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// SumBy returns the sum of the values returned by f for every element of the slice.
+func SumBy[T any, N Number, S ~[]T](s S, f func(T) N) N {
+	var sum N
+	for _, v := range s {
+		sum += f(v)
+	}
+	return sum
+}
+
+// AverageBy returns the arithmetic mean of the values returned by f for every element of the slice.
+// If the slice is empty, it returns 0.
+func AverageBy[T any, N Number, S ~[]T](s S, f func(T) N) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range s {
+		sum += float64(f(v))
+	}
+	return sum / float64(len(s))
+}
+
+// MinBy returns the first element of the slice with the smallest key returned by the key function.
+// If the slice is empty, it returns the zero value of type `T` and `false`.
+func MinBy[T any, K cmp.Ordered, S ~[]T](s S, key func(T) K) (T, bool) {
+	return extremeBy(s, key, -1)
+}
+
+// MaxBy returns the first element of the slice with the largest key returned by the key function.
+// If the slice is empty, it returns the zero value of type `T` and `false`.
+func MaxBy[T any, K cmp.Ordered, S ~[]T](s S, key func(T) K) (T, bool) {
+	return extremeBy(s, key, 1)
+}
+
+// extremeBy returns the first element of the slice whose key compares to every other key with the given sign.
+func extremeBy[T any, K cmp.Ordered, S ~[]T](s S, key func(T) K, sign int) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	best, bestKey := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); cmp.Compare(k, bestKey) == sign {
+			best, bestKey = v, k
+		}
+	}
+	return best, true
+}
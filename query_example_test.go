@@ -0,0 +1,39 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleQuery() {
+	type sale struct {
+		region string
+		amount float64
+	}
+	sales := godash.NewSlice(
+		sale{"north", 100}, sale{"south", 20}, sale{"north", 50},
+		sale{"east", 70}, sale{"south", 10}, sale{"east", 90},
+	)
+
+	byRegion := godash.GroupBy(sales.Query(), func(s sale) string { return s.region })
+	bigRegions := byRegion.
+		Having(func(g godash.Group[string, sale]) bool {
+			return godash.SumBy(g.Items, func(s sale) float64 { return s.amount }) > 100
+		}).
+		OrderByDescending(godash.CompareBy(func(g godash.Group[string, sale]) int { return g.Count() })).
+		ThenBy(godash.CompareBy(func(g godash.Group[string, sale]) string { return g.Key }))
+	report := godash.Select(bigRegions, func(g godash.Group[string, sale]) string {
+		total := godash.SumBy(g.Items, func(s sale) float64 { return s.amount })
+		return fmt.Sprintf("%s: %.0f", g.Key, total)
+	})
+
+	fmt.Println(report.ToSlice())
+	fmt.Println(report.Explain())
+	// Output:
+	// [east: 160 north: 150]
+	// 1. From: slice with 6 rows
+	// 2. GroupBy (buffered)
+	// 3. Having
+	// 4. OrderByDescending, ThenBy (buffered)
+	// 5. Select
+}
@@ -0,0 +1,337 @@
+package godash
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type queryRow struct {
+	name    string
+	team    string
+	age     int
+	salary  float64
+	project string
+}
+
+var queryRows = Slice[queryRow]{
+	{name: "ana", team: "core", age: 31, salary: 100, project: "api"},
+	{name: "bob", team: "web", age: 25, salary: 80, project: "site"},
+	{name: "carl", team: "core", age: 42, salary: 120, project: "api"},
+	{name: "dora", team: "data", age: 25, salary: 90, project: "etl"},
+	{name: "eve", team: "web", age: 38, salary: 95, project: "site"},
+	{name: "fred", team: "core", age: 25, salary: 70, project: "cli"},
+}
+
+func rowNames(rows Slice[queryRow]) []string {
+	return MustMap(rows, func(r queryRow) string { return r.name })
+}
+
+func TestQuery_Where(t *testing.T) {
+	got := queryRows.Query().
+		Where(func(r queryRow) bool { return r.team == "core" }).
+		Where(func(r queryRow) bool { return r.age < 40 }).
+		ToSlice()
+
+	if want := []string{"ana", "fred"}; !reflect.DeepEqual(rowNames(got), want) {
+		t.Errorf("Where() = %v, want %v", rowNames(got), want)
+	}
+}
+
+func TestQuery_OrderBy(t *testing.T) {
+	byAge := CompareBy(func(r queryRow) int { return r.age })
+	byName := CompareBy(func(r queryRow) string { return r.name })
+	bySalary := CompareBy(func(r queryRow) float64 { return r.salary })
+
+	tests := []struct {
+		name  string
+		query Query[queryRow]
+		want  []string
+	}{{
+		name:  "ascending order",
+		query: NewQuery(queryRows).OrderBy(bySalary),
+		want:  []string{"fred", "bob", "dora", "eve", "ana", "carl"},
+	}, {
+		name:  "descending order",
+		query: NewQuery(queryRows).OrderByDescending(bySalary),
+		want:  []string{"carl", "ana", "eve", "dora", "bob", "fred"},
+	}, {
+		name:  "stable sort keeps the original order of ties",
+		query: NewQuery(queryRows).OrderBy(byAge),
+		want:  []string{"bob", "dora", "fred", "ana", "eve", "carl"},
+	}, {
+		name:  "secondary order",
+		query: NewQuery(queryRows).OrderBy(byAge).ThenByDescending(byName),
+		want:  []string{"fred", "dora", "bob", "ana", "eve", "carl"},
+	}, {
+		name:  "descending primary and ascending secondary order",
+		query: NewQuery(queryRows).OrderByDescending(byAge).ThenBy(bySalary),
+		want:  []string{"carl", "eve", "ana", "fred", "bob", "dora"},
+	}, {
+		name:  "a new OrderBy replaces the previous one",
+		query: NewQuery(queryRows).OrderBy(byAge).OrderBy(byName),
+		want:  []string{"ana", "bob", "carl", "dora", "eve", "fred"},
+	}, {
+		name:  "a replaced OrderBy doesn't break ties",
+		query: NewQuery(queryRows).OrderByDescending(byName).OrderBy(byAge),
+		want:  []string{"bob", "dora", "fred", "ana", "eve", "carl"},
+	}, {
+		name:  "ThenBy without OrderBy",
+		query: NewQuery(queryRows).ThenBy(byName),
+		want:  []string{"ana", "bob", "carl", "dora", "eve", "fred"},
+	}, {
+		name:  "ThenByDescending without OrderBy",
+		query: NewQuery(queryRows).ThenByDescending(byName),
+		want:  []string{"fred", "eve", "dora", "carl", "bob", "ana"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rowNames(tt.query.ToSlice()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("source isn't modified", func(t *testing.T) {
+		rows := NewSlice(3, 1, 2)
+		rows.Query().OrderBy(CompareBy(func(i int) int { return i })).ToSlice()
+		if want := NewSlice(3, 1, 2); !reflect.DeepEqual(rows, want) {
+			t.Errorf("source = %v, want %v", rows, want)
+		}
+	})
+}
+
+func TestQuery_LimitAndOffset(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query[int]
+		want  Slice[int]
+	}{
+		{name: "limit", query: Range(0, 10, 1).Query().Limit(3), want: Slice[int]{0, 1, 2}},
+		{name: "offset", query: Range(0, 5, 1).Query().Offset(3), want: Slice[int]{3, 4}},
+		{name: "offset and limit", query: Range(0, 10, 1).Query().Offset(2).Limit(3), want: Slice[int]{2, 3, 4}},
+		{name: "limit greater than the rows", query: Range(0, 3, 1).Query().Limit(10), want: Slice[int]{0, 1, 2}},
+		{name: "offset greater than the rows", query: Range(0, 3, 1).Query().Offset(10), want: Slice[int]{}},
+		{name: "zero limit", query: Range(0, 3, 1).Query().Limit(0), want: Slice[int]{}},
+		{name: "negative limit", query: Range(0, 3, 1).Query().Limit(-1), want: Slice[int]{}},
+		{name: "negative offset", query: Range(0, 3, 1).Query().Offset(-1), want: Slice[int]{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_Laziness(t *testing.T) {
+	t.Run("no step runs until the query is executed", func(t *testing.T) {
+		calls := 0
+		q := Range(0, 10, 1).Query().Where(func(int) bool {
+			calls++
+			return true
+		})
+		if calls != 0 {
+			t.Fatalf("Where predicate was called %d times before execution", calls)
+		}
+
+		q.ToSlice()
+		if calls != 10 {
+			t.Errorf("Where predicate was called %d times, want 10", calls)
+		}
+	})
+
+	t.Run("limit stops reading the source", func(t *testing.T) {
+		read := 0
+		source := Seq[int](func(yield func(int) bool) {
+			for i := 0; ; i++ {
+				read++
+				if !yield(i) {
+					return
+				}
+			}
+		})
+
+		got := NewQueryFromSeq(source).Where(func(i int) bool { return i%2 == 0 }).Limit(3).ToSlice()
+		if want := (Slice[int]{0, 2, 4}); !reflect.DeepEqual(got, want) {
+			t.Errorf("ToSlice() = %v, want %v", got, want)
+		}
+		if read != 5 {
+			t.Errorf("read %d values from the source, want 5", read)
+		}
+	})
+
+	t.Run("queries are immutable", func(t *testing.T) {
+		base := Range(0, 6, 1).Query().Where(func(i int) bool { return i > 0 })
+		evens := base.Where(func(i int) bool { return i%2 == 0 })
+		odds := base.Where(func(i int) bool { return i%2 == 1 })
+
+		if got, want := base.ToSlice(), (Slice[int]{1, 2, 3, 4, 5}); !reflect.DeepEqual(got, want) {
+			t.Errorf("base = %v, want %v", got, want)
+		}
+		if got, want := evens.ToSlice(), (Slice[int]{2, 4}); !reflect.DeepEqual(got, want) {
+			t.Errorf("evens = %v, want %v", got, want)
+		}
+		if got, want := odds.ToSlice(), (Slice[int]{1, 3, 5}); !reflect.DeepEqual(got, want) {
+			t.Errorf("odds = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ordered queries are immutable", func(t *testing.T) {
+		byValue := CompareBy(func(i int) int { return i % 3 })
+		base := NewSlice(5, 2, 4, 1).Query().OrderBy(byValue)
+		ascending := base.ThenBy(CompareBy(func(i int) int { return i }))
+		descending := base.ThenByDescending(CompareBy(func(i int) int { return i }))
+
+		if got, want := ascending.ToSlice(), (Slice[int]{1, 4, 2, 5}); !reflect.DeepEqual(got, want) {
+			t.Errorf("ascending = %v, want %v", got, want)
+		}
+		if got, want := descending.ToSlice(), (Slice[int]{4, 1, 5, 2}); !reflect.DeepEqual(got, want) {
+			t.Errorf("descending = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestQuery_Distinct(t *testing.T) {
+	got := Distinct(NewSlice(3, 1, 3, 2, 1).Query()).ToSlice()
+	if want := (Slice[int]{3, 1, 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Distinct() = %v, want %v", got, want)
+	}
+
+	gotBy := DistinctBy(queryRows.Query(), func(r queryRow) int { return r.age }).ToSlice()
+	if want := []string{"ana", "bob", "carl", "eve"}; !reflect.DeepEqual(rowNames(gotBy), want) {
+		t.Errorf("DistinctBy() = %v, want %v", rowNames(gotBy), want)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	got := Select(queryRows.Query().Where(func(r queryRow) bool { return r.age == 25 }), func(r queryRow) string {
+		return strings.ToUpper(r.name)
+	}).ToSlice()
+
+	if want := (Slice[string]{"BOB", "DORA", "FRED"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Select() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	type teamSummary struct {
+		team      string
+		count     int
+		total     float64
+		average   float64
+		youngest  string
+		oldest    string
+		hasOldest bool
+	}
+
+	groups := GroupBy(queryRows.Query(), func(r queryRow) string { return r.team })
+	summaries := Select(groups.Having(func(g Group[string, queryRow]) bool { return g.Count() > 1 }),
+		func(g Group[string, queryRow]) teamSummary {
+			salary := func(r queryRow) float64 { return r.salary }
+			age := func(r queryRow) int { return r.age }
+			youngest, _ := MinBy(g.Items, age)
+			oldest, ok := MaxBy(g.Items, age)
+			return teamSummary{
+				team:      g.Key,
+				count:     g.Count(),
+				total:     SumBy(g.Items, salary),
+				average:   AverageBy(g.Items, salary),
+				youngest:  youngest.name,
+				oldest:    oldest.name,
+				hasOldest: ok,
+			}
+		}).ToSlice()
+
+	want := Slice[teamSummary]{
+		{team: "core", count: 3, total: 290, average: 290.0 / 3, youngest: "fred", oldest: "carl", hasOldest: true},
+		{team: "web", count: 2, total: 175, average: 87.5, youngest: "bob", oldest: "eve", hasOldest: true},
+	}
+	if !reflect.DeepEqual(summaries, want) {
+		t.Errorf("GroupBy() = %v, want %v", summaries, want)
+	}
+
+	t.Run("groups keep the order of their rows", func(t *testing.T) {
+		first, ok := GroupBy(queryRows.Query(), func(r queryRow) string { return r.project }).First()
+		if !ok || first.Key != "api" || !reflect.DeepEqual(rowNames(first.Items), []string{"ana", "carl"}) {
+			t.Errorf("First() = %v, %t, want the api group with ana and carl", first, ok)
+		}
+	})
+}
+
+func TestQuery_CountAndFirst(t *testing.T) {
+	q := Range(0, 10, 1).Query().Where(func(i int) bool { return i > 6 })
+	if got := q.Count(); got != 3 {
+		t.Errorf("Count() = %d, want 3", got)
+	}
+	if got, ok := q.First(); got != 7 || !ok {
+		t.Errorf("First() = %d, %t, want 7, true", got, ok)
+	}
+	if got, ok := q.Where(func(i int) bool { return i > 100 }).First(); got != 0 || ok {
+		t.Errorf("First() = %d, %t, want 0, false", got, ok)
+	}
+}
+
+func TestQuery_Explain(t *testing.T) {
+	q := Distinct(Select(
+		GroupBy(
+			queryRows.Query().
+				Where(func(r queryRow) bool { return r.age > 20 }).
+				OrderBy(CompareBy(func(r queryRow) int { return r.age })).
+				ThenByDescending(CompareBy(func(r queryRow) string { return r.name })),
+			func(r queryRow) string { return r.team },
+		).Having(func(g Group[string, queryRow]) bool { return g.Count() > 1 }).Offset(1).Limit(5),
+		func(g Group[string, queryRow]) string { return g.Key },
+	))
+
+	want := strings.Join([]string{
+		"1. From: slice with 6 rows",
+		"2. Where",
+		"3. OrderBy, ThenByDescending (buffered)",
+		"4. GroupBy (buffered)",
+		"5. Having",
+		"6. Offset: 1",
+		"7. Limit: 5",
+		"8. Select",
+		"9. Distinct",
+	}, "\n")
+	if got := q.Explain(); got != want {
+		t.Errorf("Explain() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got, want := NewQueryFromSeq(Values([]int{1})).Explain(), "1. From: sequence"; got != want {
+		t.Errorf("Explain() = %q, want %q", got, want)
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	t.Run("empty slices", func(t *testing.T) {
+		var empty []queryRow
+		salary := func(r queryRow) float64 { return r.salary }
+		if got := SumBy(empty, salary); got != 0 {
+			t.Errorf("SumBy() = %v, want 0", got)
+		}
+		if got := AverageBy(empty, salary); got != 0 {
+			t.Errorf("AverageBy() = %v, want 0", got)
+		}
+		if _, ok := MinBy(empty, salary); ok {
+			t.Error("MinBy() = true, want false")
+		}
+		if _, ok := MaxBy(empty, salary); ok {
+			t.Error("MaxBy() = true, want false")
+		}
+	})
+
+	t.Run("ties return the first element", func(t *testing.T) {
+		age := func(r queryRow) int { return r.age }
+		if got, _ := MinBy(queryRows, age); got.name != "bob" {
+			t.Errorf("MinBy() = %v, want bob", got.name)
+		}
+		if got, _ := MaxBy(NewSlice(queryRows[0], queryRows[2], queryRows[2]), age); got.name != "carl" {
+			t.Errorf("MaxBy() = %v, want carl", got.name)
+		}
+	})
+}
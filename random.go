@@ -0,0 +1,152 @@
+package godash
+
+import "math/rand/v2"
+
+// The functions in this file take a *rand.Rand so callers can use a seeded source and get deterministic results,
+// which is especially useful in tests. When a nil *rand.Rand is given, the global source of math/rand/v2 is used.
+
+// randIntN returns a random int in [0, n) from r, or from the global source if r is nil.
+func randIntN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+// randFloat64 returns a random float64 in [0.0, 1.0) from r, or from the global source if r is nil.
+func randFloat64(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
+
+// Shuffle randomly reorders the elements of a slice in place using the Fisher–Yates algorithm,
+// so every permutation is equally likely. This function modifies the original slice and returns it.
+func Shuffle[T any, S ~[]T](s S, r *rand.Rand) S {
+	for i := len(s) - 1; i > 0; i-- {
+		j := randIntN(r, i+1)
+		s[i], s[j] = s[j], s[i]
+	}
+	return s
+}
+
+// Shuffle randomly reorders the elements of the slice in place.
+// This method modifies the original slice and returns the modified slice for chaining.
+func (s Slice[T]) Shuffle(r *rand.Rand) Slice[T] {
+	return Shuffle(s, r)
+}
+
+// ToShuffled returns a new slice with the elements of the input slice randomly reordered,
+// preserving the original slice unmodified.
+func ToShuffled[T any, S ~[]T](s S, r *rand.Rand) []T {
+	result := make([]T, len(s))
+	copy(result, s)
+	return Shuffle(result, r)
+}
+
+// ToShuffled creates and returns a new slice with the elements randomly reordered,
+// leaving the original slice unchanged.
+func (s Slice[T]) ToShuffled(r *rand.Rand) Slice[T] {
+	return ToShuffled(s, r)
+}
+
+// Sample returns a random element of the slice.
+// If the slice is empty, it returns the zero value of type `T` and `false`.
+func Sample[T any, S ~[]T](s S, r *rand.Rand) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s[randIntN(r, len(s))], true
+}
+
+// Sample behaves exactly like [Sample] function, except it is called directly on the slice.
+func (s Slice[T]) Sample(r *rand.Rand) (T, bool) {
+	return Sample(s, r)
+}
+
+// SampleN returns n distinct random elements of the slice (sampling without replacement), in random order.
+// Elements are distinct by position, so equal elements may all be picked. If n is greater than the length
+// of the slice, all its elements are returned shuffled. A negative n is treated as zero.
+// The original slice is not modified.
+func SampleN[T any, S ~[]T](s S, n int, r *rand.Rand) []T {
+	n = clampCount(n, len(s))
+	pool := make([]T, len(s))
+	copy(pool, s)
+
+	// partial Fisher–Yates: only the first n positions need to be settled
+	for i := 0; i < n; i++ {
+		j := i + randIntN(r, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n]
+}
+
+// SampleN behaves exactly like [SampleN] function, except it is called directly on the slice.
+func (s Slice[T]) SampleN(n int, r *rand.Rand) Slice[T] {
+	return SampleN(s, n, r)
+}
+
+// ReservoirSample returns n random values of the sequence (sampling without replacement) consuming it only once,
+// so it can be used with sequences too large to be kept in memory. It uses Vitter's Algorithm R.
+// If the sequence has fewer than n values, all of them are returned. A negative n is treated as zero.
+// The returned values are in no meaningful order.
+func ReservoirSample[T any](seq Seq[T], n int, r *rand.Rand) Slice[T] {
+	reservoir := make(Slice[T], 0, max(n, 0))
+	if n <= 0 {
+		return reservoir
+	}
+
+	seen := 0
+	seq(func(v T) bool {
+		seen++
+		if len(reservoir) < n {
+			reservoir = append(reservoir, v)
+		} else if j := randIntN(r, seen); j < n {
+			reservoir[j] = v
+		}
+		return true
+	})
+	return reservoir
+}
+
+// WeightedChoice returns a random element of the slice, where the probability of picking each element is
+// proportional to the weight returned by the weight function. Elements with zero or negative weights are never
+// picked. If the slice is empty or no element has a positive weight, it returns the zero value of type `T`
+// and `false`.
+func WeightedChoice[T any, S ~[]T](s S, weight func(T) float64, r *rand.Rand) (T, bool) {
+	weights := make([]float64, len(s))
+	total := 0.0
+	for i, v := range s {
+		if w := weight(v); w > 0 {
+			weights[i] = w
+			total += w
+		}
+	}
+
+	var zero T
+	if total <= 0 {
+		return zero, false
+	}
+
+	target := randFloat64(r) * total
+	last := -1
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if target < w {
+			return s[i], true
+		}
+		target -= w
+		last = i
+	}
+	// rounding errors may leave a tiny remainder of the target after the loop
+	return s[last], true
+}
+
+// WeightedChoice behaves exactly like [WeightedChoice] function, except it is called directly on the slice.
+func (s Slice[T]) WeightedChoice(weight func(T) float64, r *rand.Rand) (T, bool) {
+	return WeightedChoice(s, weight, r)
+}
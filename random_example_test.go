@@ -0,0 +1,32 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+	"math/rand/v2"
+)
+
+func ExampleShuffle() {
+	r := rand.New(rand.NewPCG(1, 2)) // a seeded source makes the result reproducible
+	s := []int{1, 2, 3, 4, 5}
+
+	godash.Shuffle(s, r)
+	fmt.Println(len(s))
+	// Output:
+	// 5
+}
+
+func ExampleWeightedChoice() {
+	r := rand.New(rand.NewPCG(1, 2))
+	servers := []string{"primary", "disabled"}
+	weight := func(s string) float64 {
+		if s == "disabled" {
+			return 0
+		}
+		return 1
+	}
+
+	fmt.Println(godash.WeightedChoice(servers, weight, r))
+	// Output:
+	// primary true
+}
@@ -0,0 +1,226 @@
+package godash
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestShuffle(t *testing.T) {
+	t.Run("result is a permutation of the input", func(t *testing.T) {
+		s := Range(0, 20, 1)
+		Shuffle(s, newTestRand())
+
+		sorted := slices.Clone(s)
+		slices.Sort(sorted)
+		if !reflect.DeepEqual(sorted, Range(0, 20, 1)) {
+			t.Errorf("Shuffle() = %v, which is not a permutation of the input", s)
+		}
+	})
+
+	t.Run("same seed gives the same result", func(t *testing.T) {
+		a := Shuffle(Range(0, 20, 1), newTestRand())
+		b := Range(0, 20, 1).Shuffle(newTestRand())
+		if !reflect.DeepEqual(a, b) {
+			t.Errorf("Shuffle() = %v and %v, want equal results", a, b)
+		}
+	})
+
+	t.Run("every permutation is equally likely", func(t *testing.T) {
+		r := newTestRand()
+		counts := make(map[[3]int]int)
+		const runs = 60_000
+		for i := 0; i < runs; i++ {
+			s := Shuffle([]int{0, 1, 2}, r)
+			counts[[3]int(s)]++
+		}
+
+		if len(counts) != 6 {
+			t.Fatalf("got %d different permutations, want 6", len(counts))
+		}
+		for permutation, count := range counts {
+			if count < runs/6*9/10 || count > runs/6*11/10 {
+				t.Errorf("permutation %v happened %d times, want about %d", permutation, count, runs/6)
+			}
+		}
+	})
+
+	t.Run("empty and nil slices", func(t *testing.T) {
+		if got := Shuffle([]int{}, newTestRand()); len(got) != 0 {
+			t.Errorf("Shuffle([]) = %v, want empty", got)
+		}
+		if got := Shuffle([]int(nil), nil); got != nil {
+			t.Errorf("Shuffle(nil) = %v, want nil", got)
+		}
+	})
+}
+
+func TestToShuffled(t *testing.T) {
+	original := Range(0, 10, 1)
+	got := ToShuffled(original, newTestRand())
+
+	if !reflect.DeepEqual(original, Range(0, 10, 1)) {
+		t.Errorf("ToShuffled() modified the original slice: %v", original)
+	}
+	if want := Shuffle(Range(0, 10, 1), newTestRand()); !reflect.DeepEqual(got, []int(want)) {
+		t.Errorf("ToShuffled() = %v, want %v", got, want)
+	}
+	if gotSlice := original.ToShuffled(newTestRand()); !reflect.DeepEqual([]int(gotSlice), got) {
+		t.Errorf("s.ToShuffled() = %v, want %v", gotSlice, got)
+	}
+}
+
+func TestSample(t *testing.T) {
+	t.Run("picks elements of the slice", func(t *testing.T) {
+		r := newTestRand()
+		s := NewSlice("a", "b", "c")
+		seen := NewSet[string]()
+		for i := 0; i < 100; i++ {
+			v, ok := s.Sample(r)
+			if !ok || !Includes(s, v) {
+				t.Fatalf("Sample() = %v, %t, want an element of %v", v, ok, s)
+			}
+			seen.Add(v)
+		}
+		if seen.Size() != 3 {
+			t.Errorf("Sample() picked %v, want every element to be picked", seen)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if v, ok := Sample([]int{}, newTestRand()); v != 0 || ok {
+			t.Errorf("Sample([]) = %v, %t, want 0, false", v, ok)
+		}
+	})
+
+	t.Run("nil rand uses the global source", func(t *testing.T) {
+		if v, ok := Sample([]int{7}, nil); v != 7 || !ok {
+			t.Errorf("Sample([7], nil) = %v, %t, want 7, true", v, ok)
+		}
+	})
+}
+
+func TestSampleN(t *testing.T) {
+	tests := []struct {
+		name    string
+		n       int
+		wantLen int
+	}{
+		{name: "fewer than the length", n: 3, wantLen: 3},
+		{name: "exactly the length", n: 10, wantLen: 10},
+		{name: "more than the length", n: 20, wantLen: 10},
+		{name: "zero", n: 0, wantLen: 0},
+		{name: "negative", n: -1, wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Range(0, 10, 1)
+			got := s.SampleN(tt.n, newTestRand())
+
+			if len(got) != tt.wantLen {
+				t.Errorf("SampleN(%d) = %v, want %d elements", tt.n, got, tt.wantLen)
+			}
+			if NewSet(got...).Size() != len(got) {
+				t.Errorf("SampleN(%d) = %v, want distinct elements", tt.n, got)
+			}
+			if !reflect.DeepEqual(s, Range(0, 10, 1)) {
+				t.Errorf("SampleN(%d) modified the original slice: %v", tt.n, s)
+			}
+		})
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	t.Run("sequence longer than n", func(t *testing.T) {
+		got := ReservoirSample(Values(Range(0, 100, 1)), 5, newTestRand())
+		if len(got) != 5 || NewSet(got...).Size() != 5 {
+			t.Errorf("ReservoirSample() = %v, want 5 distinct values", got)
+		}
+	})
+
+	t.Run("sequence shorter than n", func(t *testing.T) {
+		got := ReservoirSample(Values([]int{1, 2}), 5, newTestRand())
+		if want := (Slice[int]{1, 2}); !reflect.DeepEqual(got, want) {
+			t.Errorf("ReservoirSample() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("non-positive n", func(t *testing.T) {
+		if got := ReservoirSample(Values([]int{1, 2}), 0, newTestRand()); len(got) != 0 {
+			t.Errorf("ReservoirSample(0) = %v, want empty", got)
+		}
+		if got := ReservoirSample(Values([]int{1, 2}), -1, newTestRand()); len(got) != 0 {
+			t.Errorf("ReservoirSample(-1) = %v, want empty", got)
+		}
+	})
+
+	t.Run("every value is equally likely", func(t *testing.T) {
+		r := newTestRand()
+		counts := make([]int, 10)
+		const runs = 20_000
+		for i := 0; i < runs; i++ {
+			for _, v := range ReservoirSample(Values(Range(0, 10, 1)), 2, r) {
+				counts[v]++
+			}
+		}
+		for v, count := range counts {
+			if want := runs * 2 / 10; count < want*9/10 || count > want*11/10 {
+				t.Errorf("value %d was picked %d times, want about %d", v, count, want)
+			}
+		}
+	})
+}
+
+func TestWeightedChoice(t *testing.T) {
+	weight := func(v string) float64 {
+		switch v {
+		case "common":
+			return 3
+		case "rare":
+			return 1
+		case "negative":
+			return -5
+		default:
+			return 0
+		}
+	}
+
+	t.Run("picks elements proportionally to their weights", func(t *testing.T) {
+		r := newTestRand()
+		s := NewSlice("never", "common", "negative", "rare")
+		counts := make(map[string]int)
+		const runs = 40_000
+		for i := 0; i < runs; i++ {
+			v, ok := s.WeightedChoice(weight, r)
+			if !ok {
+				t.Fatal("WeightedChoice() = false, want true")
+			}
+			counts[v]++
+		}
+
+		if counts["never"] != 0 || counts["negative"] != 0 {
+			t.Errorf("elements with non-positive weights were picked: %v", counts)
+		}
+		if ratio := float64(counts["common"]) / float64(counts["rare"]); ratio < 2.8 || ratio > 3.2 {
+			t.Errorf("common/rare ratio = %f, want about 3", ratio)
+		}
+	})
+
+	t.Run("no positive weights", func(t *testing.T) {
+		if v, ok := WeightedChoice([]string{"never", "negative"}, weight, newTestRand()); v != "" || ok {
+			t.Errorf("WeightedChoice() = %q, %t, want \"\", false", v, ok)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if v, ok := WeightedChoice([]string{}, weight, newTestRand()); v != "" || ok {
+			t.Errorf("WeightedChoice() = %q, %t, want \"\", false", v, ok)
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package godash
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrRateLimited is returned by [RateLimiter.TryCall] when the call would exceed the rate limit.
+	ErrRateLimited = errors.New("rate limit exceeded")
+	// ErrCallCanceled is returned by [RateLimiter.Call] when the call is canceled while it waits for its turn.
+	ErrCallCanceled = errors.New("call canceled")
+)
+
+// RateLimiter wraps a [Mapper] so it is called at a limited rate. It is created by [RateLimit],
+// and is safe for concurrent use.
+type RateLimiter[K any, V any] struct {
+	mapper   Mapper[K, V]
+	interval time.Duration // between two calls at the sustained rate
+	burst    int
+	clock    Clock
+
+	mu sync.Mutex
+	// next is when the token bucket would be full again if no more calls were made. A call is allowed once
+	// the bucket has a token, i.e. burst-1 intervals before next.
+	next    time.Time
+	waiting Set[*rateWaiter]
+}
+
+// rateWaiter is a call of a RateLimiter waiting for its turn.
+type rateWaiter struct {
+	timer Timer
+	done  chan error
+}
+
+// RateLimit wraps the mapper with a token bucket that allows rate calls per second on average, and bursts of up
+// to burst calls at once. Use [RateLimiter.Call] wherever a Mapper is expected: calls exceeding the rate wait
+// for their turn, in the order they were made. A burst smaller than 1 is treated as 1, and it panics if the rate
+// isn't positive. If clock is nil, the system clock is used.
+func RateLimit[K any, V any](mapper Mapper[K, V], rate float64, burst int, clock Clock) *RateLimiter[K, V] {
+	if rate <= 0 {
+		panic("godash: RateLimit called with a rate that isn't positive")
+	}
+	return &RateLimiter[K, V]{
+		mapper:   mapper,
+		interval: time.Duration(float64(time.Second) / rate),
+		burst:    max(burst, 1),
+		clock:    clockOrSystem(clock),
+		waiting:  NewSet[*rateWaiter](),
+	}
+}
+
+// Call calls the wrapped mapper with the key as soon as the rate limit allows it, blocking until then.
+// It has the signature of a [Mapper], so l.Call can be used wherever a Mapper is expected.
+// If the call is canceled by [RateLimiter.Cancel] while it waits, it returns [ErrCallCanceled] without calling
+// the mapper.
+func (l *RateLimiter[K, V]) Call(key K) (V, error) {
+	l.mu.Lock()
+	now := l.clock.Now()
+	allowedAt := l.reserve(now)
+	if !allowedAt.After(now) {
+		l.mu.Unlock()
+		return l.mapper(key)
+	}
+
+	waiter := &rateWaiter{done: make(chan error, 1)}
+	l.waiting.Add(waiter)
+	waiter.timer = l.clock.AfterFunc(allowedAt.Sub(now), func() {
+		l.release(waiter)
+	})
+	l.mu.Unlock()
+
+	if err := <-waiter.done; err != nil {
+		var zero V
+		return zero, err
+	}
+	return l.mapper(key)
+}
+
+// TryCall behaves like [RateLimiter.Call], except it returns [ErrRateLimited] right away instead of waiting
+// if the rate limit doesn't allow the call now.
+func (l *RateLimiter[K, V]) TryCall(key K) (V, error) {
+	l.mu.Lock()
+	now := l.clock.Now()
+	if l.waiting.Size() > 0 || l.allowedAt().After(now) {
+		l.mu.Unlock()
+		var zero V
+		return zero, ErrRateLimited
+	}
+	l.reserve(now)
+	l.mu.Unlock()
+	return l.mapper(key)
+}
+
+// Cancel makes every waiting call return [ErrCallCanceled]. Their turns are given back, so later calls
+// don't wait for them.
+func (l *RateLimiter[K, V]) Cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for waiter := range l.waiting {
+		if waiter.timer.Stop() {
+			l.next = l.next.Add(-l.interval)
+		}
+		l.waiting.Delete(waiter)
+		waiter.done <- ErrCallCanceled
+	}
+}
+
+// Flush lets every waiting call run right away, without waiting for its turn. Their turns are still counted,
+// so later calls wait as if the waiting calls had run on time.
+func (l *RateLimiter[K, V]) Flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for waiter := range l.waiting {
+		waiter.timer.Stop()
+		l.waiting.Delete(waiter)
+		waiter.done <- nil
+	}
+}
+
+// Pending returns the number of calls waiting for their turn.
+func (l *RateLimiter[K, V]) Pending() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.waiting.Size()
+}
+
+// allowedAt returns when the next call is allowed. It must be called with the lock held.
+func (l *RateLimiter[K, V]) allowedAt() time.Time {
+	return l.next.Add(-time.Duration(l.burst-1) * l.interval)
+}
+
+// reserve takes a turn for a call made at the given time and returns when it is allowed to run.
+// It must be called with the lock held.
+func (l *RateLimiter[K, V]) reserve(now time.Time) time.Time {
+	allowedAt := maxTime(now, l.allowedAt())
+	l.next = maxTime(now, l.next).Add(l.interval)
+	return allowedAt
+}
+
+// release lets a waiting call run, unless it was already canceled or flushed.
+func (l *RateLimiter[K, V]) release(waiter *rateWaiter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.waiting.Has(waiter) {
+		l.waiting.Delete(waiter)
+		waiter.done <- nil
+	}
+}
@@ -0,0 +1,132 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// newTestRateLimiter returns a RateLimiter allowing 10 calls per second with bursts of 2 calls, whose mapper
+// reports the time it was called at, relative to the start of the clock.
+func newTestRateLimiter() (*RateLimiter[string, string], *FakeClock) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	limiter := RateLimit(func(key string) (string, error) {
+		return fmt.Sprintf("%s@%v", key, clock.Now().Sub(start)), nil
+	}, 10, 2, clock)
+	return limiter, clock
+}
+
+// callInBackground starts a Call of the limiter and waits until it is waiting for its turn.
+func callInBackground(limiter *RateLimiter[string, string], key string) <-chan Result[string] {
+	result := make(chan Result[string], 1)
+	pending := limiter.Pending()
+	go func() {
+		result <- NewResult(limiter.Call(key))
+	}()
+	for limiter.Pending() == pending {
+		runtime.Gosched()
+	}
+	return result
+}
+
+func TestRateLimiter_TryCall(t *testing.T) {
+	limiter, clock := newTestRateLimiter()
+
+	got := make([]string, 0)
+	for _, key := range []string{"a", "b", "c"} {
+		v, err := limiter.TryCall(key)
+		got = append(got, fmt.Sprint(v, err))
+	}
+	clock.Advance(100 * time.Millisecond)
+	v, err := limiter.TryCall("d")
+	got = append(got, fmt.Sprint(v, err))
+
+	want := []string{"a@0s<nil>", "b@0s<nil>", "rate limit exceeded", "d@100ms<nil>"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+}
+
+func TestRateLimiter_Call(t *testing.T) {
+	limiter, clock := newTestRateLimiter()
+
+	got, err := Map([]string{"a", "b"}, limiter.Call)
+	if err != nil || fmt.Sprint(got) != "[a@0s b@0s]" {
+		t.Errorf("Map() = %v, %v, want [a@0s b@0s], nil", got, err)
+	}
+
+	c := callInBackground(limiter, "c")
+	d := callInBackground(limiter, "d")
+	if _, err := limiter.TryCall("e"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("TryCall() returned %v while calls are waiting, want %v", err, ErrRateLimited)
+	}
+
+	clock.Advance(100 * time.Millisecond)
+	if got := (<-c).String(); got != "Ok(c@100ms)" {
+		t.Errorf("Call(c) = %s, want Ok(c@100ms)", got)
+	}
+	clock.Advance(100 * time.Millisecond)
+	if got := (<-d).String(); got != "Ok(d@200ms)" {
+		t.Errorf("Call(d) = %s, want Ok(d@200ms)", got)
+	}
+	if limiter.Pending() != 0 {
+		t.Errorf("Pending() = %d, want 0", limiter.Pending())
+	}
+}
+
+func TestRateLimiter_Cancel(t *testing.T) {
+	limiter, clock := newTestRateLimiter()
+	_, _ = limiter.Call("a")
+	_, _ = limiter.Call("b")
+
+	results := []<-chan Result[string]{callInBackground(limiter, "c"), callInBackground(limiter, "d")}
+	limiter.Cancel()
+	for _, result := range results {
+		if err := (<-result).Err(); !errors.Is(err, ErrCallCanceled) {
+			t.Errorf("Call() returned %v, want %v", err, ErrCallCanceled)
+		}
+	}
+
+	// the turns of the canceled calls were given back
+	clock.Advance(100 * time.Millisecond)
+	if v, err := limiter.TryCall("e"); err != nil || v != "e@100ms" {
+		t.Errorf("TryCall() = %q, %v, want \"e@100ms\", nil", v, err)
+	}
+}
+
+func TestRateLimiter_Flush(t *testing.T) {
+	limiter, clock := newTestRateLimiter()
+	_, _ = limiter.Call("a")
+	_, _ = limiter.Call("b")
+
+	c := callInBackground(limiter, "c")
+	limiter.Flush()
+	if got := (<-c).String(); got != "Ok(c@0s)" {
+		t.Errorf("Call(c) = %s, want Ok(c@0s)", got)
+	}
+	if clock.PendingTimers() != 0 {
+		t.Errorf("PendingTimers() = %d after Flush(), want 0", clock.PendingTimers())
+	}
+
+	// the turn of the flushed call is still counted
+	clock.Advance(100 * time.Millisecond)
+	if _, err := limiter.TryCall("d"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("TryCall() returned %v, want %v", err, ErrRateLimited)
+	}
+	clock.Advance(100 * time.Millisecond)
+	if _, err := limiter.TryCall("d"); err != nil {
+		t.Errorf("TryCall() returned %v, want nil", err)
+	}
+}
+
+func TestRateLimit_InvalidRate(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("RateLimit() didn't panic with a zero rate")
+		}
+	}()
+	RateLimit(func(int) (int, error) { return 0, nil }, 0, 1, nil)
+}
@@ -0,0 +1,241 @@
+package godash
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// ReadOnlySlice is a view of a [Slice] that only exposes the methods that don't change it, so it can be handed
+// to code that must not change the slice. It is created by [Slice.Freeze], which doesn't copy the slice, so
+// changes made through the Slice itself are visible through the view. Methods returning slices return
+// read-only slices too, which may share the elements of the view.
+//
+// The zero value is an empty slice.
+type ReadOnlySlice[T any] struct {
+	s Slice[T]
+}
+
+// Freeze returns a read-only view of the slice, without copying it.
+func (s Slice[T]) Freeze() ReadOnlySlice[T] {
+	return ReadOnlySlice[T]{s: s}
+}
+
+// ToSlice returns a copy of the elements of the slice, which can be changed.
+func (r ReadOnlySlice[T]) ToSlice() Slice[T] {
+	return slices.Clone(r.s)
+}
+
+// Len returns the number of elements of the slice.
+func (r ReadOnlySlice[T]) Len() int {
+	return len(r.s)
+}
+
+// At behaves like [Slice.At].
+func (r ReadOnlySlice[T]) At(index int) T {
+	return r.s.At(index)
+}
+
+// AtOpt behaves like [Slice.AtOpt].
+func (r ReadOnlySlice[T]) AtOpt(index int) Option[T] {
+	return r.s.AtOpt(index)
+}
+
+// First behaves like [Slice.First].
+func (r ReadOnlySlice[T]) First() (T, bool) {
+	return r.s.First()
+}
+
+// Last behaves like [Slice.Last].
+func (r ReadOnlySlice[T]) Last() (T, bool) {
+	return r.s.Last()
+}
+
+// Every behaves like [Slice.Every].
+func (r ReadOnlySlice[T]) Every(p Predicate[T]) bool {
+	return r.s.Every(p)
+}
+
+// Some behaves like [Slice.Some].
+func (r ReadOnlySlice[T]) Some(p Predicate[T]) bool {
+	return r.s.Some(p)
+}
+
+// Find behaves like [Slice.Find].
+func (r ReadOnlySlice[T]) Find(p Predicate[T]) (T, bool) {
+	return r.s.Find(p)
+}
+
+// FindOpt behaves like [Slice.FindOpt].
+func (r ReadOnlySlice[T]) FindOpt(p Predicate[T]) Option[T] {
+	return r.s.FindOpt(p)
+}
+
+// FindIndex behaves like [Slice.FindIndex].
+func (r ReadOnlySlice[T]) FindIndex(p Predicate[T]) (int, bool) {
+	return r.s.FindIndex(p)
+}
+
+// FindLast behaves like [Slice.FindLast].
+func (r ReadOnlySlice[T]) FindLast(p Predicate[T]) (T, bool) {
+	return r.s.FindLast(p)
+}
+
+// FindLastOpt behaves like [Slice.FindLastOpt].
+func (r ReadOnlySlice[T]) FindLastOpt(p Predicate[T]) Option[T] {
+	return r.s.FindLastOpt(p)
+}
+
+// FindLastIndex behaves like [Slice.FindLastIndex].
+func (r ReadOnlySlice[T]) FindLastIndex(p Predicate[T]) (int, bool) {
+	return r.s.FindLastIndex(p)
+}
+
+// ForEach behaves like [Slice.ForEach].
+func (r ReadOnlySlice[T]) ForEach(f func(i int, v T)) {
+	r.s.ForEach(f)
+}
+
+// Filter behaves like [Slice.Filter], returning a new read-only slice.
+func (r ReadOnlySlice[T]) Filter(p Predicate[T]) ReadOnlySlice[T] {
+	return r.s.Filter(p).Freeze()
+}
+
+// Fill behaves like [Slice.Fill], returning a new read-only slice.
+func (r ReadOnlySlice[T]) Fill(value T, positions ...int) ReadOnlySlice[T] {
+	return r.s.Fill(value, positions...).Freeze()
+}
+
+// Take behaves like [Slice.Take].
+func (r ReadOnlySlice[T]) Take(n int) ReadOnlySlice[T] {
+	return r.s.Take(n).Freeze()
+}
+
+// TakeRight behaves like [Slice.TakeRight].
+func (r ReadOnlySlice[T]) TakeRight(n int) ReadOnlySlice[T] {
+	return r.s.TakeRight(n).Freeze()
+}
+
+// TakeWhile behaves like [Slice.TakeWhile].
+func (r ReadOnlySlice[T]) TakeWhile(p Predicate[T]) ReadOnlySlice[T] {
+	return r.s.TakeWhile(p).Freeze()
+}
+
+// Drop behaves like [Slice.Drop].
+func (r ReadOnlySlice[T]) Drop(n int) ReadOnlySlice[T] {
+	return r.s.Drop(n).Freeze()
+}
+
+// DropRight behaves like [Slice.DropRight].
+func (r ReadOnlySlice[T]) DropRight(n int) ReadOnlySlice[T] {
+	return r.s.DropRight(n).Freeze()
+}
+
+// DropWhile behaves like [Slice.DropWhile].
+func (r ReadOnlySlice[T]) DropWhile(p Predicate[T]) ReadOnlySlice[T] {
+	return r.s.DropWhile(p).Freeze()
+}
+
+// Initial behaves like [Slice.Initial].
+func (r ReadOnlySlice[T]) Initial() ReadOnlySlice[T] {
+	return r.s.Initial().Freeze()
+}
+
+// Tail behaves like [Slice.Tail].
+func (r ReadOnlySlice[T]) Tail() ReadOnlySlice[T] {
+	return r.s.Tail().Freeze()
+}
+
+// ToReversed behaves like [Slice.ToReversed], returning a new read-only slice.
+func (r ReadOnlySlice[T]) ToReversed() ReadOnlySlice[T] {
+	return r.s.ToReversed().Freeze()
+}
+
+// ToShuffled behaves like [Slice.ToShuffled], returning a new read-only slice.
+func (r ReadOnlySlice[T]) ToShuffled(rnd *rand.Rand) ReadOnlySlice[T] {
+	return r.s.ToShuffled(rnd).Freeze()
+}
+
+// Sample behaves like [Slice.Sample].
+func (r ReadOnlySlice[T]) Sample(rnd *rand.Rand) (T, bool) {
+	return r.s.Sample(rnd)
+}
+
+// SampleN behaves like [Slice.SampleN], returning a new read-only slice.
+func (r ReadOnlySlice[T]) SampleN(n int, rnd *rand.Rand) ReadOnlySlice[T] {
+	return r.s.SampleN(n, rnd).Freeze()
+}
+
+// WeightedChoice behaves like [Slice.WeightedChoice].
+func (r ReadOnlySlice[T]) WeightedChoice(weight func(T) float64, rnd *rand.Rand) (T, bool) {
+	return r.s.WeightedChoice(weight, rnd)
+}
+
+// Values behaves like [Slice.Values].
+func (r ReadOnlySlice[T]) Values() Seq[T] {
+	return r.s.Values()
+}
+
+// String formats the elements like a slice.
+func (r ReadOnlySlice[T]) String() string {
+	return fmt.Sprint([]T(r.s))
+}
+
+// ReadOnlySet is a view of a [Set] that only exposes the methods that don't change it, so it can be handed to
+// code that must not change the set. It is created by [Set.Freeze], which doesn't copy the set, so changes made
+// through the Set itself are visible through the view. Methods returning sets return new read-only sets.
+//
+// The zero value is an empty set.
+type ReadOnlySet[T setElement] struct {
+	s Set[T]
+}
+
+// Freeze returns a read-only view of the set, without copying it.
+func (s Set[T]) Freeze() ReadOnlySet[T] {
+	return ReadOnlySet[T]{s: s}
+}
+
+// ToSet returns a copy of the elements of the set, which can be changed.
+func (r ReadOnlySet[T]) ToSet() Set[T] {
+	return NewSet(r.Values()...)
+}
+
+// Has behaves like [Set.Has].
+func (r ReadOnlySet[T]) Has(element T) bool {
+	return r.s.Has(element)
+}
+
+// Size behaves like [Set.Size].
+func (r ReadOnlySet[T]) Size() int {
+	return r.s.Size()
+}
+
+// Values behaves like [Set.Values], returning the elements in a new slice.
+func (r ReadOnlySet[T]) Values() []T {
+	return r.s.Values()
+}
+
+// Intersection behaves like [Set.Intersection], returning a new read-only set.
+func (r ReadOnlySet[T]) Intersection(other ReadOnlySet[T]) ReadOnlySet[T] {
+	return r.s.Intersection(other.s).Freeze()
+}
+
+// Union behaves like [Set.Union], returning a new read-only set.
+func (r ReadOnlySet[T]) Union(other ReadOnlySet[T]) ReadOnlySet[T] {
+	return r.s.Union(other.s).Freeze()
+}
+
+// Difference behaves like [Set.Difference], returning a new read-only set.
+func (r ReadOnlySet[T]) Difference(other ReadOnlySet[T]) ReadOnlySet[T] {
+	return r.s.Difference(other.s).Freeze()
+}
+
+// Random behaves like [Set.Random].
+func (r ReadOnlySet[T]) Random(rnd *rand.Rand) (T, bool) {
+	return r.s.Random(rnd)
+}
+
+// String behaves like [Set.String].
+func (r ReadOnlySet[T]) String() string {
+	return r.s.String()
+}
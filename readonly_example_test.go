@@ -0,0 +1,30 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleSlice_Freeze() {
+	scores := godash.Slice[int]{70, 95, 82}
+	view := scores.Freeze()
+
+	high := view.Filter(func(score int) bool { return score > 80 })
+	scores[0] = 99 // visible through the view, which doesn't copy the slice
+
+	fmt.Println(view, high, view.Len())
+	// Output:
+	// [99 95 82] [95 82] 3
+}
+
+func ExampleSet_Freeze() {
+	roles := godash.NewSet("admin", "editor")
+	view := roles.Freeze()
+
+	copied := view.ToSet()
+	copied.Add("viewer")
+
+	fmt.Println(view.Has("viewer"), copied.Has("viewer"))
+	// Output:
+	// false true
+}
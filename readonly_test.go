@@ -0,0 +1,106 @@
+package godash
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestReadOnly_MethodSets(t *testing.T) {
+	tests := []struct {
+		t        reflect.Type
+		mutating []string
+	}{
+		{reflect.TypeFor[ReadOnlySlice[int]](), []string{"Push", "Pop", "Shift", "Unshift", "Reverse", "Shuffle", "PopOpt", "ShiftOpt", "ToRaw"}},
+		{reflect.TypeFor[*ReadOnlySlice[int]](), []string{"Push", "Pop", "Shift", "Unshift", "Reverse", "Shuffle", "PopOpt", "ShiftOpt", "ToRaw"}},
+		{reflect.TypeFor[ReadOnlySet[int]](), []string{"Add", "Delete", "Clear"}},
+		{reflect.TypeFor[*ReadOnlySet[int]](), []string{"Add", "Delete", "Clear"}},
+	}
+
+	for _, tt := range tests {
+		for _, name := range tt.mutating {
+			if _, ok := tt.t.MethodByName(name); ok {
+				t.Errorf("%v has the method %s, which can change the underlying data", tt.t, name)
+			}
+		}
+	}
+}
+
+func TestReadOnlySlice(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4}
+	r := s.Freeze()
+
+	if r.Len() != 4 || r.At(1) != 2 || r.At(-1) != 4 {
+		t.Errorf("Len() = %d, At(1) = %d, At(-1) = %d, want 4, 2, 4", r.Len(), r.At(1), r.At(-1))
+	}
+	if v, ok := r.Find(func(v int) bool { return v > 2 }); !ok || v != 3 {
+		t.Errorf("Find() = %d, %t, want 3, true", v, ok)
+	}
+	if !r.Every(func(v int) bool { return v > 0 }) || r.Some(func(v int) bool { return v > 4 }) {
+		t.Error("Every() and Some() don't match the elements")
+	}
+
+	s[0] = 10
+	if first, _ := r.First(); first != 10 {
+		t.Errorf("First() = %d, want the change made to the underlying slice", first)
+	}
+
+	even := r.Filter(func(v int) bool { return v%2 == 0 })
+	s[1] = 20
+	if got := even.ToSlice(); !slices.Equal(got, Slice[int]{10, 2, 4}) {
+		t.Errorf("Filter() = %v, want a new slice [10 2 4]", got)
+	}
+	if got := r.Tail().Take(2).ToReversed().String(); got != "[3 20]" {
+		t.Errorf("Tail().Take(2).ToReversed() = %s, want [3 20]", got)
+	}
+
+	mutable := r.ToSlice()
+	mutable.Push(5)
+	mutable[0] = 0
+	if r.Len() != 4 || s[0] != 10 {
+		t.Error("changing the copy returned by ToSlice() changed the underlying slice")
+	}
+
+	var zero ReadOnlySlice[int]
+	if zero.Len() != 0 || zero.Filter(func(int) bool { return true }).Len() != 0 {
+		t.Error("the zero value should be an empty slice")
+	}
+}
+
+func TestReadOnlySet(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	r := s.Freeze()
+
+	if !r.Has(2) || r.Size() != 3 {
+		t.Errorf("Has(2) = %t, Size() = %d, want true, 3", r.Has(2), r.Size())
+	}
+	s.Add(4)
+	if !r.Has(4) {
+		t.Error("Has(4) = false, want the change made to the underlying set")
+	}
+
+	other := NewSet(3, 4, 5).Freeze()
+	for name, tt := range map[string]struct {
+		got  ReadOnlySet[int]
+		want string
+	}{
+		"Union":        {r.Union(other), "set{1, 2, 3, 4, 5}"},
+		"Intersection": {r.Intersection(other), "set{3, 4}"},
+		"Difference":   {r.Difference(other), "set{1, 2}"},
+	} {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s() = %s, want %s", name, got, tt.want)
+		}
+	}
+
+	mutable := r.ToSet()
+	mutable.Delete(1)
+	if !r.Has(1) {
+		t.Error("changing the copy returned by ToSet() changed the underlying set")
+	}
+
+	var zero ReadOnlySet[string]
+	if zero.Size() != 0 || zero.Has("a") || zero.Union(NewSet("a").Freeze()).Size() != 1 {
+		t.Error("the zero value should be an empty set")
+	}
+}
@@ -0,0 +1,113 @@
+package godash
+
+import "fmt"
+
+// Result represents the outcome of an operation that may fail: it either holds a value (Ok) or an error (Err).
+// It is an alternative to (T, error) pairs that can be chained and stored in slices, like the ones returned
+// by [MapResults]. The zero value of Result is Ok with the zero value of type `T`.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// ResultOk creates a successful Result holding the given value.
+func ResultOk[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// ResultErr creates a failed Result holding the given error.
+func ResultErr[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// NewResult creates a Result from a (T, error) pair, as returned by a [Mapper]:
+// it is Err if err isn't nil, and Ok holding value otherwise.
+func NewResult[T any](value T, err error) Result[T] {
+	if err != nil {
+		return ResultErr[T](err)
+	}
+	return ResultOk(value)
+}
+
+// IsOk checks whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr checks whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error of the Result as a (T, error) pair.
+// If the Result is Err, the value is the zero value of type `T`.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error of the Result, or nil if it is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value of the Result. It panics with the error if the Result is Err.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value of the Result, or defaultValue if it is Err.
+func (r Result[T]) UnwrapOr(defaultValue T) T {
+	if r.err != nil {
+		return defaultValue
+	}
+	return r.value
+}
+
+// Map returns a Result holding the result of applying the mapper to the value, or the Result itself if it is Err.
+// Use [ResultMap] to map the value to a different type.
+func (r Result[T]) Map(mapper MustMapper[T, T]) Result[T] {
+	return ResultMap(r, mapper)
+}
+
+// AndThen returns the Result of calling f with the value, or the Result itself if it is Err.
+// Use [ResultAndThen] to chain operations returning a different type.
+func (r Result[T]) AndThen(f func(T) Result[T]) Result[T] {
+	return ResultAndThen(r, f)
+}
+
+// String returns "Ok(value)" or "Err(error)". The value is converted to a string using the format "%v".
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// ResultMap returns a Result holding the result of applying the mapper to the value of r,
+// or the error of r if it is Err.
+func ResultMap[T any, R any](r Result[T], mapper MustMapper[T, R]) Result[R] {
+	if r.err != nil {
+		return ResultErr[R](r.err)
+	}
+	return ResultOk(mapper(r.value))
+}
+
+// ResultAndThen returns the Result of calling f with the value of r, or the error of r if it is Err.
+func ResultAndThen[T any, R any](r Result[T], f func(T) Result[R]) Result[R] {
+	if r.err != nil {
+		return ResultErr[R](r.err)
+	}
+	return f(r.value)
+}
+
+// MapResults applies the mapper to each element of the slice and returns a Result for each one of them.
+// Unlike [Map], it doesn't abort on the first error: every element is mapped, and the failed ones are
+// returned as Err.
+func MapResults[TIn any, TOut any, S ~[]TIn](s S, mapper Mapper[TIn, TOut]) []Result[TOut] {
+	return MustMap(s, func(v TIn) Result[TOut] {
+		return NewResult(mapper(v))
+	})
+}
@@ -0,0 +1,121 @@
+package godash
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name         string
+		result       Result[int]
+		wantValue    int
+		wantErr      error
+		wantUnwrapOr int
+		wantString   string
+	}{
+		{name: "ok", result: ResultOk(3), wantValue: 3, wantUnwrapOr: 3, wantString: "Ok(3)"},
+		{name: "err", result: ResultErr[int](errFailed), wantErr: errFailed, wantUnwrapOr: -1, wantString: "Err(failed)"},
+		{name: "zero value", result: Result[int]{}, wantValue: 0, wantUnwrapOr: 0, wantString: "Ok(0)"},
+		{name: "from a successful pair", result: NewResult(5, nil), wantValue: 5, wantUnwrapOr: 5, wantString: "Ok(5)"},
+		{name: "from a failed pair", result: NewResult(5, errFailed), wantErr: errFailed, wantUnwrapOr: -1, wantString: "Err(failed)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.result.IsOk(); got != (tt.wantErr == nil) {
+				t.Errorf("IsOk() = %t, want %t", got, tt.wantErr == nil)
+			}
+			if got := tt.result.IsErr(); got != (tt.wantErr != nil) {
+				t.Errorf("IsErr() = %t, want %t", got, tt.wantErr != nil)
+			}
+			if got, err := tt.result.Get(); got != tt.wantValue || !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get() = %d, %v, want %d, %v", got, err, tt.wantValue, tt.wantErr)
+			}
+			if got := tt.result.Err(); !errors.Is(got, tt.wantErr) {
+				t.Errorf("Err() = %v, want %v", got, tt.wantErr)
+			}
+			if got := tt.result.UnwrapOr(-1); got != tt.wantUnwrapOr {
+				t.Errorf("UnwrapOr(-1) = %d, want %d", got, tt.wantUnwrapOr)
+			}
+			if got := tt.result.String(); got != tt.wantString {
+				t.Errorf("String() = %q, want %q", got, tt.wantString)
+			}
+		})
+	}
+}
+
+func TestResult_Unwrap(t *testing.T) {
+	if got := ResultOk("a").Unwrap(); got != "a" {
+		t.Errorf("Unwrap() = %q, want %q", got, "a")
+	}
+
+	errFailed := errors.New("failed")
+	defer func() {
+		if r := recover(); r != errFailed {
+			t.Errorf("Unwrap() on Err panicked with %v, want %v", r, errFailed)
+		}
+	}()
+	ResultErr[string](errFailed).Unwrap()
+}
+
+func TestResult_MapAndThen(t *testing.T) {
+	errFailed := errors.New("failed")
+	double := func(i int) int { return i * 2 }
+	halve := func(i int) Result[int] {
+		if i%2 != 0 {
+			return ResultErr[int](errors.New("odd number"))
+		}
+		return ResultOk(i / 2)
+	}
+
+	if got := ResultOk(2).Map(double); got != ResultOk(4) {
+		t.Errorf("Map() = %v, want Ok(4)", got)
+	}
+	if got := ResultErr[int](errFailed).Map(double); got.Err() != errFailed {
+		t.Errorf("Map() = %v, want Err(failed)", got)
+	}
+	if got := ResultOk(4).AndThen(halve).AndThen(halve); got != ResultOk(1) {
+		t.Errorf("AndThen() = %v, want Ok(1)", got)
+	}
+	if got := ResultOk(2).AndThen(halve).AndThen(halve); got.IsOk() {
+		t.Errorf("AndThen() = %v, want Err", got)
+	}
+	if got := ResultErr[int](errFailed).AndThen(halve); got.Err() != errFailed {
+		t.Errorf("AndThen() = %v, want Err(failed)", got)
+	}
+
+	if got := ResultMap(ResultOk(2), strconv.Itoa); got != ResultOk("2") {
+		t.Errorf("ResultMap() = %v, want Ok(2)", got)
+	}
+	if got := ResultMap(ResultErr[int](errFailed), strconv.Itoa); got.Err() != errFailed {
+		t.Errorf("ResultMap() = %v, want Err(failed)", got)
+	}
+	if got := ResultAndThen(ResultOk("12"), func(s string) Result[int] { return NewResult(strconv.Atoi(s)) }); got != ResultOk(12) {
+		t.Errorf("ResultAndThen() = %v, want Ok(12)", got)
+	}
+	if got := ResultAndThen(ResultErr[string](errFailed), func(s string) Result[int] { return ResultOk(0) }); got.Err() != errFailed {
+		t.Errorf("ResultAndThen() = %v, want Err(failed)", got)
+	}
+}
+
+func TestMapResults(t *testing.T) {
+	got := MapResults([]string{"1", "x", "3"}, strconv.Atoi)
+	if len(got) != 3 {
+		t.Fatalf("MapResults() = %v, want 3 results", got)
+	}
+	if got[0] != ResultOk(1) || got[2] != ResultOk(3) {
+		t.Errorf("MapResults() = %v, want Ok(1) and Ok(3) on the edges", got)
+	}
+	if !got[1].IsErr() {
+		t.Errorf("MapResults()[1] = %v, want Err", got[1])
+	}
+
+	if got := MapResults([]string{}, strconv.Atoi); !reflect.DeepEqual(got, []Result[int]{}) {
+		t.Errorf("MapResults([]) = %v, want empty", got)
+	}
+}
@@ -0,0 +1,183 @@
+package godash
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// Backoff defines a function type that returns how long to wait before retrying after the given failed attempt,
+// numbered from 1. The previous argument is the delay returned for the previous attempt, or zero after the first one.
+type Backoff func(attempt int, previous time.Duration) time.Duration
+
+// ConstantBackoff returns a Backoff that always waits for delay.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int, time.Duration) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff returns a Backoff that waits for base after the first attempt and doubles the delay after
+// every other attempt, up to maxDelay. A maxDelay smaller than 1 means no limit, and the delay then stops
+// growing at the longest time.Duration instead of overflowing.
+func ExponentialBackoff(base, maxDelay time.Duration) Backoff {
+	if maxDelay < 1 {
+		maxDelay = math.MaxInt64
+	}
+	return func(attempt int, _ time.Duration) time.Duration {
+		delay := min(base, maxDelay)
+		for i := 1; i < attempt && delay > 0 && delay < maxDelay; i++ {
+			// Clamping before doubling keeps the delay from overflowing when maxDelay is close to the limit.
+			if delay > maxDelay/2 {
+				delay = maxDelay
+			} else {
+				delay *= 2
+			}
+		}
+		return delay
+	}
+}
+
+// DecorrelatedJitterBackoff returns a Backoff that waits for a random delay between base and three times the
+// previous delay, up to maxDelay, as described in the "Exponential Backoff And Jitter" article of the AWS
+// Architecture Blog. The randomness spreads the retries of concurrent callers. The random values come from r,
+// or from the global source of math/rand/v2 if r is nil.
+func DecorrelatedJitterBackoff(base, maxDelay time.Duration, r *rand.Rand) Backoff {
+	return func(_ int, previous time.Duration) time.Duration {
+		upper := max(3*previous, base)
+		delay := base + time.Duration(randFloat64(r)*float64(upper-base))
+		return min(delay, maxDelay)
+	}
+}
+
+// RetryPolicy configures [Retry]. The zero value makes a single attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of calls of the mapper for each input, including the first one.
+	// A value smaller than 1 is treated as 1.
+	MaxAttempts int
+	// Backoff tells how long to wait before each retry. If nil, retries happen right away.
+	Backoff Backoff
+	// Context stops the retries once it is done. If its deadline would pass while waiting for a retry,
+	// the retries stop right away instead. If nil, context.Background() is used.
+	Context context.Context
+	// Retryable tells whether a failed attempt can be retried. If nil, every error is retryable.
+	Retryable Predicate[error]
+	// OnRetry, if set, is called before waiting for each retry with the failed attempt, its error and the delay.
+	OnRetry func(attempt int, err error, delay time.Duration)
+	// Clock tells the time compared to the deadline of the Context, and schedules the end of the waits done by
+	// the default Sleep. If nil, the system clock is used.
+	Clock Clock
+	// Sleep waits for the delay before a retry, returning an error if the context is done before the end of the
+	// delay. If nil, it waits using the Clock.
+	Sleep func(ctx context.Context, delay time.Duration) error
+}
+
+// RetryError is returned by the mappers created by [Retry] when every attempt failed, or when the retries were
+// stopped. It wraps the error of every attempt, so [errors.Is] and [errors.As] look into all of them.
+type RetryError struct {
+	// Errors holds the error of every attempt, in order.
+	Errors []error
+	// Cause is the error of the context if it stopped the retries, or nil otherwise.
+	Cause error
+}
+
+// Error describes the error of every attempt.
+func (e *RetryError) Error() string {
+	attempts := fmt.Sprintf("%d attempts", len(e.Errors))
+	if len(e.Errors) == 1 {
+		attempts = "1 attempt"
+	}
+
+	var builder strings.Builder
+	if e.Cause != nil {
+		builder.WriteString(fmt.Sprintf("gave up after %s: %v", attempts, e.Cause))
+	} else {
+		builder.WriteString("failed after " + attempts)
+	}
+	for i, err := range e.Errors {
+		builder.WriteString(fmt.Sprintf("; attempt %d: %v", i+1, err))
+	}
+	return builder.String()
+}
+
+// Unwrap returns the error of every attempt, followed by the Cause if it isn't nil.
+func (e *RetryError) Unwrap() []error {
+	if e.Cause == nil {
+		return e.Errors
+	}
+	return append(e.Errors[:len(e.Errors):len(e.Errors)], e.Cause)
+}
+
+// Retry wraps the mapper so failed calls are retried as configured by the policy. If an attempt succeeds, its
+// result is returned. Otherwise, the retries stop when the maximum number of attempts is reached, when an error
+// isn't retryable or when the context is done, and a *[RetryError] holding the error of every attempt is returned.
+func Retry[TInput any, TOutput any](mapper Mapper[TInput, TOutput], policy RetryPolicy) Mapper[TInput, TOutput] {
+	ctx := policy.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	clock := clockOrSystem(policy.Clock)
+	sleep := policy.Sleep
+	if sleep == nil {
+		sleep = clockSleeper(clock)
+	}
+
+	return func(input TInput) (TOutput, error) {
+		var zero TOutput
+		failure := &RetryError{Errors: make([]error, 0)}
+		var previous time.Duration
+		for attempt := 1; ; attempt++ {
+			if err := ctx.Err(); err != nil {
+				failure.Cause = err
+				return zero, failure
+			}
+
+			output, err := mapper(input)
+			if err == nil {
+				return output, nil
+			}
+			failure.Errors = append(failure.Errors, err)
+			if attempt >= policy.MaxAttempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+				return zero, failure
+			}
+
+			var delay time.Duration
+			if policy.Backoff != nil {
+				delay = policy.Backoff(attempt, previous)
+			}
+			if deadline, ok := ctx.Deadline(); ok && clock.Now().Add(delay).After(deadline) {
+				failure.Cause = context.DeadlineExceeded
+				return zero, failure
+			}
+			if policy.OnRetry != nil {
+				policy.OnRetry(attempt, err, delay)
+			}
+			if err := sleep(ctx, delay); err != nil {
+				failure.Cause = err
+				return zero, failure
+			}
+			previous = delay
+		}
+	}
+}
+
+// clockSleeper returns a function that waits for a delay using the clock, unless the context is done first.
+func clockSleeper(clock Clock) func(ctx context.Context, delay time.Duration) error {
+	return func(ctx context.Context, delay time.Duration) error {
+		if delay <= 0 {
+			return ctx.Err()
+		}
+		done := make(chan struct{})
+		timer := clock.AfterFunc(delay, func() { close(done) })
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package godash_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/taciogt/godash"
+	"time"
+)
+
+func ExampleRetry() {
+	errUnavailable := errors.New("service unavailable")
+	attempts := 0
+	fetchPrice := func(item string) (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, errUnavailable
+		}
+		return len(item) * 100, nil
+	}
+
+	fetchWithRetries := godash.Retry(fetchPrice, godash.RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     godash.ExponentialBackoff(100*time.Millisecond, time.Second),
+		Retryable:   func(err error) bool { return errors.Is(err, errUnavailable) },
+		OnRetry: func(attempt int, err error, delay time.Duration) {
+			fmt.Printf("attempt %d failed with %q, retrying in %v\n", attempt, err, delay)
+		},
+		// skips the waits, so the example runs instantly
+		Sleep: func(context.Context, time.Duration) error { return nil },
+	})
+
+	fmt.Println(godash.Map([]string{"apple"}, fetchWithRetries))
+	// Output:
+	// attempt 1 failed with "service unavailable", retrying in 100ms
+	// attempt 2 failed with "service unavailable", retrying in 200ms
+	// [500] <nil>
+}
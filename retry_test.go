@@ -0,0 +1,248 @@
+package godash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+var errFlaky = errors.New("flaky")
+
+// flakyMapper returns a Mapper that fails with the given errors, in order, before succeeding, along with
+// a pointer to the number of calls made.
+func flakyMapper(errs ...error) (Mapper[int, int], *int) {
+	calls := 0
+	return func(i int) (int, error) {
+		calls++
+		if calls <= len(errs) {
+			return 0, errs[calls-1]
+		}
+		return i * 10, nil
+	}, &calls
+}
+
+// fakeSleep returns a Sleep function that advances the clock instead of waiting, recording the delays.
+func fakeSleep(clock *FakeClock, delays *[]time.Duration) func(context.Context, time.Duration) error {
+	return func(ctx context.Context, delay time.Duration) error {
+		*delays = append(*delays, delay)
+		clock.Advance(delay)
+		return ctx.Err()
+	}
+}
+
+func TestRetry(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	delays := make([]time.Duration, 0)
+	retries := make([]string, 0)
+	mapper, calls := flakyMapper(errFlaky, errFlaky)
+
+	retrying := Retry(mapper, RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     ExponentialBackoff(time.Second, time.Minute),
+		OnRetry: func(attempt int, err error, delay time.Duration) {
+			retries = append(retries, fmt.Sprintf("%d:%v:%v", attempt, err, delay))
+		},
+		Clock: clock,
+		Sleep: fakeSleep(clock, &delays),
+	})
+
+	got, err := retrying(4)
+	if got != 40 || err != nil {
+		t.Errorf("Retry()(4) = %d, %v, want 40, nil", got, err)
+	}
+	if *calls != 3 {
+		t.Errorf("mapper was called %d times, want 3", *calls)
+	}
+	if want := []string{"1:flaky:1s", "2:flaky:2s"}; fmt.Sprint(retries) != fmt.Sprint(want) {
+		t.Errorf("OnRetry calls = %v, want %v", retries, want)
+	}
+	if want := []time.Duration{time.Second, 2 * time.Second}; fmt.Sprint(delays) != fmt.Sprint(want) {
+		t.Errorf("delays = %v, want %v", delays, want)
+	}
+}
+
+func TestRetry_Failures(t *testing.T) {
+	errPermanent := errors.New("permanent")
+	start := time.Now()
+
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		errs      []error
+		wantCalls int
+		wantError string
+		wantCause error
+	}{
+		{
+			name:      "zero policy makes a single attempt",
+			errs:      []error{errFlaky},
+			wantCalls: 1,
+			wantError: "failed after 1 attempt; attempt 1: flaky",
+		},
+		{
+			name:      "max attempts",
+			policy:    RetryPolicy{MaxAttempts: 3},
+			errs:      []error{errFlaky, errFlaky, errors.New("still flaky"), errFlaky},
+			wantCalls: 3,
+			wantError: "failed after 3 attempts; attempt 1: flaky; attempt 2: flaky; attempt 3: still flaky",
+		},
+		{
+			name: "errors that aren't retryable",
+			policy: RetryPolicy{MaxAttempts: 5, Retryable: func(err error) bool {
+				return !errors.Is(err, errPermanent)
+			}},
+			errs:      []error{errFlaky, fmt.Errorf("wrapped: %w", errPermanent), errFlaky},
+			wantCalls: 2,
+			wantError: "failed after 2 attempts; attempt 1: flaky; attempt 2: wrapped: permanent",
+		},
+		{
+			name: "deadline would pass while waiting",
+			policy: RetryPolicy{
+				MaxAttempts: 5,
+				Backoff:     ConstantBackoff(40 * time.Minute),
+				Context:     contextWithDeadline(t, start.Add(time.Hour)),
+			},
+			errs:      []error{errFlaky, errFlaky, errFlaky},
+			wantCalls: 2,
+			wantError: "gave up after 2 attempts: context deadline exceeded; attempt 1: flaky; attempt 2: flaky",
+			wantCause: context.DeadlineExceeded,
+		},
+		{
+			name:      "context already done",
+			policy:    RetryPolicy{MaxAttempts: 5, Context: canceledContext()},
+			errs:      []error{errFlaky},
+			wantCalls: 0,
+			wantError: "gave up after 0 attempts: context canceled",
+			wantCause: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := NewFakeClock(start)
+			delays := make([]time.Duration, 0)
+			tt.policy.Clock, tt.policy.Sleep = clock, fakeSleep(clock, &delays)
+			mapper, calls := flakyMapper(tt.errs...)
+
+			_, err := Retry(mapper, tt.policy)(1)
+
+			var retryErr *RetryError
+			if !errors.As(err, &retryErr) {
+				t.Fatalf("Retry() returned %v, want a *RetryError", err)
+			}
+			if err.Error() != tt.wantError {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.wantError)
+			}
+			if retryErr.Cause != tt.wantCause || (tt.wantCause != nil && !errors.Is(err, tt.wantCause)) {
+				t.Errorf("Cause = %v, want %v", retryErr.Cause, tt.wantCause)
+			}
+			for _, attemptErr := range retryErr.Errors {
+				if !errors.Is(err, attemptErr) {
+					t.Errorf("errors.Is(err, %v) = false, want true", attemptErr)
+				}
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("mapper was called %d times, want %d", *calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func contextWithDeadline(t *testing.T, deadline time.Time) context.Context {
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func canceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func TestRetry_DefaultSleep(t *testing.T) {
+	t.Run("waits using the clock", func(t *testing.T) {
+		mapper, calls := flakyMapper(errFlaky)
+		got, err := Retry(mapper, RetryPolicy{MaxAttempts: 2, Backoff: ConstantBackoff(time.Millisecond)})(1)
+		if got != 10 || err != nil || *calls != 2 {
+			t.Errorf("Retry()(1) = %d, %v with %d calls, want 10, nil with 2 calls", got, err, *calls)
+		}
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		clock := NewFakeClock(time.Now())
+		mapper, calls := flakyMapper(errFlaky, errFlaky)
+
+		_, err := Retry(mapper, RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     ConstantBackoff(time.Hour),
+			Context:     ctx,
+			OnRetry:     func(int, error, time.Duration) { cancel() },
+			Clock:       clock,
+		})(1)
+
+		if !errors.Is(err, context.Canceled) || *calls != 1 {
+			t.Errorf("Retry()(1) returned %v with %d calls, want %v with 1 call", err, *calls, context.Canceled)
+		}
+		if clock.PendingTimers() != 0 {
+			t.Errorf("PendingTimers() = %d, want the wait to be stopped", clock.PendingTimers())
+		}
+	})
+}
+
+func TestBackoffs(t *testing.T) {
+	t.Run("ConstantBackoff", func(t *testing.T) {
+		backoff := ConstantBackoff(time.Second)
+		if got := backoff(1, 0) + backoff(5, time.Second); got != 2*time.Second {
+			t.Errorf("delays add up to %v, want 2s", got)
+		}
+	})
+
+	t.Run("ExponentialBackoff", func(t *testing.T) {
+		backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+		got := MustMap(Range(1, 7, 1), func(attempt int) time.Duration { return backoff(attempt, 0) })
+		want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+			800 * time.Millisecond, time.Second, time.Second}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("delays = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("ExponentialBackoff near the longest duration", func(t *testing.T) {
+		maxDelay := time.Duration(math.MaxInt64 - 1)
+		backoff := ExponentialBackoff(time.Second, maxDelay)
+		if got := backoff(100, 0); got != maxDelay {
+			t.Errorf("delay = %v, want %v", got, maxDelay)
+		}
+	})
+
+	t.Run("ExponentialBackoff without limit", func(t *testing.T) {
+		backoff := ExponentialBackoff(time.Second, 0)
+		got := MustMap(Range(1, 5, 1), func(attempt int) time.Duration { return backoff(attempt, 0) })
+		want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("delays = %v, want %v", got, want)
+		}
+		if got := backoff(100, 0); got != math.MaxInt64 {
+			t.Errorf("delay = %v, want %v", got, time.Duration(math.MaxInt64))
+		}
+	})
+
+	t.Run("DecorrelatedJitterBackoff", func(t *testing.T) {
+		base, maxDelay := 100*time.Millisecond, 5*time.Second
+		backoff := DecorrelatedJitterBackoff(base, maxDelay, newTestRand())
+		var previous time.Duration
+		for attempt := 1; attempt <= 50; attempt++ {
+			delay := backoff(attempt, previous)
+			if delay < base || delay > maxDelay || delay > max(3*previous, base) {
+				t.Fatalf("attempt %d waits for %v after %v, want between %v and min(%v, 3*previous)",
+					attempt, delay, previous, base, maxDelay)
+			}
+			previous = delay
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package godash
+
+// Seq is an iterator over a sequence of values of type T.
+// It calls yield for each value in the sequence and stops as soon as yield returns false.
+//
+// Seq has the same underlying type as iter.Seq from the standard library, so starting from Go 1.23 it can be
+// used directly in range-over-func loops and passed to functions expecting an iter.Seq. With older Go versions,
+// call it with a yield function instead:
+//
+//	seq(func(v T) bool {
+//	    fmt.Println(v)
+//	    return true
+//	})
+type Seq[T any] func(yield func(T) bool)
+
+// Collect consumes the sequence and returns a Slice with its values, in the order they are yielded.
+// It must not be called with infinite sequences.
+func Collect[T any](seq Seq[T]) Slice[T] {
+	result := make(Slice[T], 0)
+	seq(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return result
+}
+
+// Values returns a Seq over the elements of the slice, in order.
+func Values[T any, S ~[]T](s S) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values behaves exactly like [Values] function, except it is called directly on the slice.
+func (s Slice[T]) Values() Seq[T] {
+	return Values(s)
+}
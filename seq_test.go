@@ -0,0 +1,41 @@
+package godash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  Slice[int]
+	}{
+		{name: "several values", input: []int{3, 1, 2}, want: Slice[int]{3, 1, 2}},
+		{name: "empty sequence", input: []int{}, want: Slice[int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(Values(tt.input)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Collect(Values(%v)) = %v, want %v", tt.input, got, tt.want)
+			}
+			if got := Collect(NewSlice(tt.input...).Values()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Collect(s.Values()) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValuesSeq(t *testing.T) {
+	t.Run("stops when yield returns false", func(t *testing.T) {
+		var got []int
+		Values([]int{1, 2, 3, 4})(func(v int) bool {
+			got = append(got, v)
+			return v < 2
+		})
+		if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+			t.Errorf("yielded %v, want %v", got, want)
+		}
+	})
+}
@@ -1,7 +1,9 @@
 package godash
 
 import (
+	"cmp"
 	"fmt"
+	"math/rand/v2"
 	"slices"
 	"strings"
 )
@@ -135,3 +137,27 @@ func (s Set[T]) String() string {
 	elementsStrJoined := strings.Join(elementsStr, ", ")
 	return fmt.Sprintf("set{%s}", elementsStrJoined)
 }
+
+// Random returns a random element of the set.
+// If the set is empty, it returns the zero value of type `T` and `false`.
+// The elements are sorted before picking one, the same way as in [Set.String], so the result is deterministic
+// for a seeded *rand.Rand. When r is nil, the global source of math/rand/v2 is used.
+func (s Set[T]) Random(r *rand.Rand) (T, bool) {
+	return Sample(sortedElements(s), r)
+}
+
+// sortedElements returns the elements of set sorted by their string representation, and then by their type,
+// for sets of interfaces holding values of different types printed the same way, like 1 and "1". Elements that
+// are printed the same way and have the same type, like two NaNs, are left in no particular order.
+func sortedElements[T setElement](set Set[T]) []T {
+	type sortKey struct{ value, typ string }
+	elements := set.Values()
+	keys := make(map[T]sortKey, len(elements))
+	for _, e := range elements {
+		keys[e] = sortKey{value: fmt.Sprintf("%v", e), typ: fmt.Sprintf("%T", e)}
+	}
+	slices.SortStableFunc(elements, func(a, b T) int {
+		return cmp.Or(strings.Compare(keys[a].value, keys[b].value), strings.Compare(keys[a].typ, keys[b].typ))
+	})
+	return elements
+}
@@ -1,6 +1,7 @@
 package godash
 
 import (
+	"math/rand/v2"
 	"reflect"
 	"runtime"
 	"slices"
@@ -344,3 +345,49 @@ func TestValues(t *testing.T) {
 		})
 	}
 }
+
+func TestSet_Random(t *testing.T) {
+	t.Run("picks elements of the set", func(t *testing.T) {
+		s := NewSet(1, 2, 3)
+		r := rand.New(rand.NewPCG(1, 2))
+		for i := 0; i < 20; i++ {
+			if v, ok := s.Random(r); !ok || !s.Has(v) {
+				t.Fatalf("Random() = %v, %t, want an element of %v", v, ok, s)
+			}
+		}
+	})
+
+	t.Run("same seed gives the same result", func(t *testing.T) {
+		pick := func() []int {
+			r := rand.New(rand.NewPCG(1, 2))
+			result := make([]int, 10)
+			for i := range result {
+				result[i], _ = NewSet(Range(0, 100, 1)...).Random(r)
+			}
+			return result
+		}
+		if a, b := pick(), pick(); !reflect.DeepEqual(a, b) {
+			t.Errorf("Random() = %v and %v, want equal results", a, b)
+		}
+	})
+
+	t.Run("elements printed the same way", func(t *testing.T) {
+		pick := func() []any {
+			r := rand.New(rand.NewPCG(1, 2))
+			result := make([]any, 10)
+			for i := range result {
+				result[i], _ = NewSet[any](1, "1", 2, "2").Random(r)
+			}
+			return result
+		}
+		if a, b := pick(), pick(); !reflect.DeepEqual(a, b) {
+			t.Errorf("Random() = %#v and %#v, want equal results", a, b)
+		}
+	})
+
+	t.Run("empty set", func(t *testing.T) {
+		if v, ok := NewSet[int]().Random(nil); v != 0 || ok {
+			t.Errorf("Random() = %v, %t, want 0, false", v, ok)
+		}
+	})
+}
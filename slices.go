@@ -390,3 +390,155 @@ func (s *Slice[T]) Unshift(value ...T) (length int) {
 	*s = NewSlice(rawSlice...)
 	return length
 }
+
+// clampCount limits n to the range [0, length], so positional selectors never slice out of bounds.
+func clampCount(n, length int) int {
+	return min(max(n, 0), length)
+}
+
+// First returns the first element of the slice.
+// If the slice is empty, it returns the zero value of type `T` and `false`.
+func First[T any, S ~[]T](s S) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s[0], true
+}
+
+// First behaves exactly like [First] function, except it is called directly on the slice.
+func (s Slice[T]) First() (T, bool) {
+	return First(s)
+}
+
+// Last returns the last element of the slice.
+// If the slice is empty, it returns the zero value of type `T` and `false`.
+func Last[T any, S ~[]T](s S) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s[len(s)-1], true
+}
+
+// Last behaves exactly like [Last] function, except it is called directly on the slice.
+func (s Slice[T]) Last() (T, bool) {
+	return Last(s)
+}
+
+// Take returns the first n elements of the slice, or the whole slice if it has fewer than n elements.
+// A negative n is treated as zero.
+//
+// The result shares the backing array with the original slice, so changing its elements changes the original.
+// Its capacity is limited to its length, so appending to it never overwrites elements of the original slice.
+func Take[T any, S ~[]T](s S, n int) S {
+	n = clampCount(n, len(s))
+	return s[:n:n]
+}
+
+// Take behaves exactly like [Take] function, except it is called directly on the slice.
+func (s Slice[T]) Take(n int) Slice[T] {
+	return Take(s, n)
+}
+
+// TakeRight returns the last n elements of the slice, or the whole slice if it has fewer than n elements.
+// A negative n is treated as zero.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func TakeRight[T any, S ~[]T](s S, n int) S {
+	n = clampCount(n, len(s))
+	return s[len(s)-n : len(s) : len(s)]
+}
+
+// TakeRight behaves exactly like [TakeRight] function, except it is called directly on the slice.
+func (s Slice[T]) TakeRight(n int) Slice[T] {
+	return TakeRight(s, n)
+}
+
+// TakeWhile returns the elements from the beginning of the slice up to, but not including,
+// the first element that doesn't satisfy the provided predicate function.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func TakeWhile[T any, S ~[]T](s S, p Predicate[T]) S {
+	i, ok := FindIndex(s, func(v T) bool { return !p(v) })
+	if !ok {
+		i = len(s)
+	}
+	return s[:i:i]
+}
+
+// TakeWhile behaves exactly like [TakeWhile] function, except it is called directly on the slice.
+func (s Slice[T]) TakeWhile(p Predicate[T]) Slice[T] {
+	return TakeWhile(s, p)
+}
+
+// Drop returns the slice without its first n elements, or an empty slice if it has fewer than n elements.
+// A negative n is treated as zero.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func Drop[T any, S ~[]T](s S, n int) S {
+	n = clampCount(n, len(s))
+	return s[n:len(s):len(s)]
+}
+
+// Drop behaves exactly like [Drop] function, except it is called directly on the slice.
+func (s Slice[T]) Drop(n int) Slice[T] {
+	return Drop(s, n)
+}
+
+// DropRight returns the slice without its last n elements, or an empty slice if it has fewer than n elements.
+// A negative n is treated as zero.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func DropRight[T any, S ~[]T](s S, n int) S {
+	n = len(s) - clampCount(n, len(s))
+	return s[:n:n]
+}
+
+// DropRight behaves exactly like [DropRight] function, except it is called directly on the slice.
+func (s Slice[T]) DropRight(n int) Slice[T] {
+	return DropRight(s, n)
+}
+
+// DropWhile returns the slice starting at the first element that doesn't satisfy the provided predicate function.
+// If every element satisfies the predicate, an empty slice is returned.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func DropWhile[T any, S ~[]T](s S, p Predicate[T]) S {
+	i, ok := FindIndex(s, func(v T) bool { return !p(v) })
+	if !ok {
+		i = len(s)
+	}
+	return s[i:len(s):len(s)]
+}
+
+// DropWhile behaves exactly like [DropWhile] function, except it is called directly on the slice.
+func (s Slice[T]) DropWhile(p Predicate[T]) Slice[T] {
+	return DropWhile(s, p)
+}
+
+// Initial returns all elements of the slice except the last one.
+// If the slice is empty, an empty slice is returned.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func Initial[T any, S ~[]T](s S) S {
+	return DropRight(s, 1)
+}
+
+// Initial behaves exactly like [Initial] function, except it is called directly on the slice.
+func (s Slice[T]) Initial() Slice[T] {
+	return Initial(s)
+}
+
+// Tail returns all elements of the slice except the first one.
+// If the slice is empty, an empty slice is returned.
+//
+// The result shares the backing array with the original slice, the same way as [Take].
+func Tail[T any, S ~[]T](s S) S {
+	return Drop(s, 1)
+}
+
+// Tail behaves exactly like [Tail] function, except it is called directly on the slice.
+func (s Slice[T]) Tail() Slice[T] {
+	return Tail(s)
+}
@@ -298,3 +298,21 @@ func ExampleSlice_Unshift() {
 	// 5
 	// [1 2 3 4 5]
 }
+
+func ExampleSlice_Take() {
+	s := godash.NewSlice(1, 2, 3, 4, 5)
+	fmt.Println(s.Take(2))
+	fmt.Println(s.TakeRight(2))
+	fmt.Println(s.Take(10))
+	// Output:
+	// [1 2]
+	// [4 5]
+	// [1 2 3 4 5]
+}
+
+func ExampleDropWhile() {
+	isNegative := func(n int) bool { return n < 0 }
+	fmt.Println(godash.DropWhile([]int{-2, -1, 0, 1, -3}, isNegative))
+	// Output:
+	// [0 1 -3]
+}
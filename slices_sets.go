@@ -0,0 +1,167 @@
+package godash
+
+// This file provides set-like operations over plain slices. Unlike the [Set] type, these operations preserve
+// the order of the input slices: the results list elements in the order they first appear.
+//
+// Each operation comes in three flavours:
+//   - over comparable elements, e.g. [IntersectSlices];
+//   - over any element type, comparing the keys returned by a key function, e.g. [IntersectSlicesBy];
+//   - over any element type, using a custom equality function, e.g. [IntersectSlicesWith].
+//
+// The first two flavours use a hash set internally and run in O(n+m). The ...With variants can't hash
+// their elements, so they compare every pair of elements and run in O(n·m).
+
+// identity returns its argument unchanged. It is used to reuse the ...By implementations for comparable elements.
+func identity[T any](v T) T {
+	return v
+}
+
+// IntersectSlices returns the unique elements of s that are also present in other,
+// in the order they first appear in s.
+func IntersectSlices[T comparable, S ~[]T](s S, other S) []T {
+	return IntersectSlicesBy(s, other, identity[T])
+}
+
+// IntersectSlicesBy behaves like [IntersectSlices], except elements are compared by the keys returned by key.
+// When several elements have the same key, the first one of s is kept.
+func IntersectSlicesBy[T any, K comparable, S ~[]T](s S, other S, key MustMapper[T, K]) []T {
+	otherKeys := keySet(other, key)
+	seen := NewSet[K]()
+	result := make([]T, 0)
+	for _, v := range s {
+		k := key(v)
+		if otherKeys.Has(k) && !seen.Has(k) {
+			seen.Add(k)
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// IntersectSlicesWith behaves like [IntersectSlices], except elements are compared with the equal function.
+func IntersectSlicesWith[T any, S ~[]T](s S, other S, equal func(a, b T) bool) []T {
+	result := make([]T, 0)
+	for _, v := range s {
+		if containsWith(other, v, equal) && !containsWith(result, v, equal) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// UnionSlices returns the unique elements present in s or other,
+// in the order they first appear in s followed by other.
+func UnionSlices[T comparable, S ~[]T](s S, other S) []T {
+	return UnionSlicesBy(s, other, identity[T])
+}
+
+// UnionSlicesBy behaves like [UnionSlices], except elements are compared by the keys returned by key.
+// When several elements have the same key, the first one is kept.
+func UnionSlicesBy[T any, K comparable, S ~[]T](s S, other S, key MustMapper[T, K]) []T {
+	seen := NewSet[K]()
+	result := make([]T, 0)
+	for _, slice := range []S{s, other} {
+		for _, v := range slice {
+			if k := key(v); !seen.Has(k) {
+				seen.Add(k)
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// UnionSlicesWith behaves like [UnionSlices], except elements are compared with the equal function.
+func UnionSlicesWith[T any, S ~[]T](s S, other S, equal func(a, b T) bool) []T {
+	result := make([]T, 0)
+	for _, slice := range []S{s, other} {
+		for _, v := range slice {
+			if !containsWith(result, v, equal) {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// DifferenceSlices returns the elements of s that are not present in other, in the order they appear in s.
+// Unlike the other set-like operations, duplicated elements of s are kept.
+func DifferenceSlices[T comparable, S ~[]T](s S, other S) []T {
+	return DifferenceSlicesBy(s, other, identity[T])
+}
+
+// DifferenceSlicesBy behaves like [DifferenceSlices], except elements are compared by the keys returned by key.
+func DifferenceSlicesBy[T any, K comparable, S ~[]T](s S, other S, key MustMapper[T, K]) []T {
+	otherKeys := keySet(other, key)
+	return Filter(s, func(v T) bool {
+		return !otherKeys.Has(key(v))
+	})
+}
+
+// DifferenceSlicesWith behaves like [DifferenceSlices], except elements are compared with the equal function.
+func DifferenceSlicesWith[T any, S ~[]T](s S, other S, equal func(a, b T) bool) []T {
+	return Filter(s, func(v T) bool {
+		return !containsWith(other, v, equal)
+	})
+}
+
+// XorSlices returns the unique elements present in exactly one of s and other (their symmetric difference),
+// in the order they first appear in s followed by other.
+func XorSlices[T comparable, S ~[]T](s S, other S) []T {
+	return XorSlicesBy(s, other, identity[T])
+}
+
+// XorSlicesBy behaves like [XorSlices], except elements are compared by the keys returned by key.
+// When several elements have the same key, the first one is kept.
+func XorSlicesBy[T any, K comparable, S ~[]T](s S, other S, key MustMapper[T, K]) []T {
+	seen := NewSet[K]()
+	result := make([]T, 0)
+	for _, pair := range []struct {
+		slice        S
+		excludedKeys Set[K]
+	}{{s, keySet(other, key)}, {other, keySet(s, key)}} {
+		for _, v := range pair.slice {
+			if k := key(v); !pair.excludedKeys.Has(k) && !seen.Has(k) {
+				seen.Add(k)
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// XorSlicesWith behaves like [XorSlices], except elements are compared with the equal function.
+func XorSlicesWith[T any, S ~[]T](s S, other S, equal func(a, b T) bool) []T {
+	result := make([]T, 0)
+	for _, pair := range []struct{ slice, excluded S }{{s, other}, {other, s}} {
+		for _, v := range pair.slice {
+			if !containsWith(pair.excluded, v, equal) && !containsWith(result, v, equal) {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// Without returns the elements of s that are not equal to any of the given values, in the order they appear in s.
+// Duplicated elements of s are kept. Use [DifferenceSlicesBy] or [DifferenceSlicesWith] for elements that
+// aren't comparable.
+func Without[T comparable, S ~[]T](s S, values ...T) []T {
+	return DifferenceSlices(s, values)
+}
+
+// keySet returns a Set with the keys of every element of s.
+func keySet[T any, K comparable, S ~[]T](s S, key MustMapper[T, K]) Set[K] {
+	keys := make(Set[K], len(s))
+	for _, v := range s {
+		keys.Add(key(v))
+	}
+	return keys
+}
+
+// containsWith checks whether any element of s is equal to value according to the equal function.
+func containsWith[T any, S ~[]T](s S, value T, equal func(a, b T) bool) bool {
+	return Some(s, func(v T) bool {
+		return equal(v, value)
+	})
+}
@@ -0,0 +1,155 @@
+package godash
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSliceSetOperations(t *testing.T) {
+	tests := []struct {
+		name             string
+		s, other         []int
+		wantIntersection []int
+		wantUnion        []int
+		wantDifference   []int
+		wantXor          []int
+	}{{
+		name:             "overlapping slices",
+		s:                []int{3, 1, 2, 1, 4},
+		other:            []int{4, 5, 3, 5},
+		wantIntersection: []int{3, 4},
+		wantUnion:        []int{3, 1, 2, 4, 5},
+		wantDifference:   []int{1, 2, 1},
+		wantXor:          []int{1, 2, 5},
+	}, {
+		name:             "disjoint slices",
+		s:                []int{1, 2},
+		other:            []int{3, 4},
+		wantIntersection: []int{},
+		wantUnion:        []int{1, 2, 3, 4},
+		wantDifference:   []int{1, 2},
+		wantXor:          []int{1, 2, 3, 4},
+	}, {
+		name:             "equal slices",
+		s:                []int{1, 2},
+		other:            []int{2, 1},
+		wantIntersection: []int{1, 2},
+		wantUnion:        []int{1, 2},
+		wantDifference:   []int{},
+		wantXor:          []int{},
+	}, {
+		name:             "empty other slice",
+		s:                []int{2, 2, 1},
+		other:            []int{},
+		wantIntersection: []int{},
+		wantUnion:        []int{2, 1},
+		wantDifference:   []int{2, 2, 1},
+		wantXor:          []int{2, 1},
+	}, {
+		name:             "nil slices",
+		s:                nil,
+		other:            nil,
+		wantIntersection: []int{},
+		wantUnion:        []int{},
+		wantDifference:   []int{},
+		wantXor:          []int{},
+	}}
+
+	equal := func(a, b int) bool { return a == b }
+	key := func(v int) string { return strings.Repeat("x", v) }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(name string, got, want []int) {
+				t.Helper()
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s(%v, %v) = %v, want %v", name, tt.s, tt.other, got, want)
+				}
+			}
+
+			check("IntersectSlices", IntersectSlices(tt.s, tt.other), tt.wantIntersection)
+			check("IntersectSlicesBy", IntersectSlicesBy(tt.s, tt.other, key), tt.wantIntersection)
+			check("IntersectSlicesWith", IntersectSlicesWith(tt.s, tt.other, equal), tt.wantIntersection)
+
+			check("UnionSlices", UnionSlices(tt.s, tt.other), tt.wantUnion)
+			check("UnionSlicesBy", UnionSlicesBy(tt.s, tt.other, key), tt.wantUnion)
+			check("UnionSlicesWith", UnionSlicesWith(tt.s, tt.other, equal), tt.wantUnion)
+
+			check("DifferenceSlices", DifferenceSlices(tt.s, tt.other), tt.wantDifference)
+			check("DifferenceSlicesBy", DifferenceSlicesBy(tt.s, tt.other, key), tt.wantDifference)
+			check("DifferenceSlicesWith", DifferenceSlicesWith(tt.s, tt.other, equal), tt.wantDifference)
+
+			check("XorSlices", XorSlices(tt.s, tt.other), tt.wantXor)
+			check("XorSlicesBy", XorSlicesBy(tt.s, tt.other, key), tt.wantXor)
+			check("XorSlicesWith", XorSlicesWith(tt.s, tt.other, equal), tt.wantXor)
+
+			s, other := NewComparableSlice(tt.s...), NewComparableSlice(tt.other...)
+			check("s.Intersection", s.Intersection(other).ToRaw(), tt.wantIntersection)
+			check("s.Union", s.Union(other).ToRaw(), tt.wantUnion)
+			check("s.Difference", s.Difference(other).ToRaw(), tt.wantDifference)
+			check("s.Xor", s.Xor(other).ToRaw(), tt.wantXor)
+		})
+	}
+
+	t.Run("the first element with a given key is kept", func(t *testing.T) {
+		s := []customStruct{{int: 1, string: "a"}, {int: 1, string: "b"}, {int: 2, string: "c"}}
+		other := []customStruct{{int: 1, string: "z"}, {int: 3, string: "y"}}
+		byInt := func(cs customStruct) int { return cs.int }
+
+		if got, want := IntersectSlicesBy(s, other, byInt), s[:1]; !reflect.DeepEqual(got, want) {
+			t.Errorf("IntersectSlicesBy() = %v, want %v", got, want)
+		}
+		if got, want := UnionSlicesBy(s, other, byInt), []customStruct{s[0], s[2], other[1]}; !reflect.DeepEqual(got, want) {
+			t.Errorf("UnionSlicesBy() = %v, want %v", got, want)
+		}
+		if got, want := XorSlicesBy(s, other, byInt), []customStruct{s[2], other[1]}; !reflect.DeepEqual(got, want) {
+			t.Errorf("XorSlicesBy() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("custom equality function", func(t *testing.T) {
+		s := []string{"Apple", "banana", "APPLE", "cherry"}
+		other := []string{"BANANA", "date"}
+
+		if got, want := IntersectSlicesWith(s, other, strings.EqualFold), []string{"banana"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("IntersectSlicesWith() = %v, want %v", got, want)
+		}
+		if got, want := UnionSlicesWith(s, other, strings.EqualFold), []string{"Apple", "banana", "cherry", "date"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("UnionSlicesWith() = %v, want %v", got, want)
+		}
+		if got, want := DifferenceSlicesWith(s, other, strings.EqualFold), []string{"Apple", "APPLE", "cherry"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("DifferenceSlicesWith() = %v, want %v", got, want)
+		}
+		if got, want := XorSlicesWith(s, other, strings.EqualFold), []string{"Apple", "cherry", "date"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("XorSlicesWith() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestWithout(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      []string
+		values []string
+		want   []string
+	}{
+		{name: "remove values", s: []string{"a", "b", "c", "a"}, values: []string{"a", "c"}, want: []string{"b"}},
+		{name: "duplicates are kept", s: []string{"b", "a", "b"}, values: []string{"a"}, want: []string{"b", "b"}},
+		{name: "no values", s: []string{"a", "b"}, values: nil, want: []string{"a", "b"}},
+		{name: "empty slice", s: []string{}, values: []string{"a"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Without(tt.s, tt.values...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Without(%v, %v) = %v, want %v", tt.s, tt.values, got, tt.want)
+			}
+
+			s := NewComparableSlice(tt.s...)
+			if got := s.Without(tt.values...); !reflect.DeepEqual(got, NewComparableSlice(tt.want...)) {
+				t.Errorf("s.Without(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
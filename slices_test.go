@@ -1495,3 +1495,188 @@ func TestUnshift(t *testing.T) {
 		})
 	}
 }
+
+func TestFirstAndLast(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		wantFirst int
+		wantLast  int
+		wantOk    bool
+	}{
+		{name: "several elements", input: []int{1, 2, 3}, wantFirst: 1, wantLast: 3, wantOk: true},
+		{name: "single element", input: []int{7}, wantFirst: 7, wantLast: 7, wantOk: true},
+		{name: "empty slice", input: []int{}, wantOk: false},
+		{name: "nil slice", input: nil, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := First(tt.input); got != tt.wantFirst || ok != tt.wantOk {
+				t.Errorf("First(%v) = %v, %t, want %v, %t", tt.input, got, ok, tt.wantFirst, tt.wantOk)
+			}
+			if got, ok := NewSlice(tt.input...).First(); got != tt.wantFirst || ok != tt.wantOk {
+				t.Errorf("s.First() = %v, %t, want %v, %t", got, ok, tt.wantFirst, tt.wantOk)
+			}
+			if got, ok := Last(tt.input); got != tt.wantLast || ok != tt.wantOk {
+				t.Errorf("Last(%v) = %v, %t, want %v, %t", tt.input, got, ok, tt.wantLast, tt.wantOk)
+			}
+			if got, ok := NewSlice(tt.input...).Last(); got != tt.wantLast || ok != tt.wantOk {
+				t.Errorf("s.Last() = %v, %t, want %v, %t", got, ok, tt.wantLast, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestTakeAndDrop(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name          string
+		n             int
+		wantTake      []int
+		wantTakeRight []int
+		wantDrop      []int
+		wantDropRight []int
+	}{{
+		name:          "n within bounds",
+		n:             2,
+		wantTake:      []int{1, 2},
+		wantTakeRight: []int{4, 5},
+		wantDrop:      []int{3, 4, 5},
+		wantDropRight: []int{1, 2, 3},
+	}, {
+		name:          "zero n",
+		n:             0,
+		wantTake:      []int{},
+		wantTakeRight: []int{},
+		wantDrop:      []int{1, 2, 3, 4, 5},
+		wantDropRight: []int{1, 2, 3, 4, 5},
+	}, {
+		name:          "negative n",
+		n:             -1,
+		wantTake:      []int{},
+		wantTakeRight: []int{},
+		wantDrop:      []int{1, 2, 3, 4, 5},
+		wantDropRight: []int{1, 2, 3, 4, 5},
+	}, {
+		name:          "n greater than the length",
+		n:             10,
+		wantTake:      []int{1, 2, 3, 4, 5},
+		wantTakeRight: []int{1, 2, 3, 4, 5},
+		wantDrop:      []int{},
+		wantDropRight: []int{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSlice(input...)
+
+			if got := Take(input, tt.n); !reflect.DeepEqual(got, tt.wantTake) {
+				t.Errorf("Take(%d) = %v, want %v", tt.n, got, tt.wantTake)
+			}
+			if got := s.Take(tt.n); !reflect.DeepEqual(got, NewSlice(tt.wantTake...)) {
+				t.Errorf("s.Take(%d) = %v, want %v", tt.n, got, tt.wantTake)
+			}
+			if got := TakeRight(input, tt.n); !reflect.DeepEqual(got, tt.wantTakeRight) {
+				t.Errorf("TakeRight(%d) = %v, want %v", tt.n, got, tt.wantTakeRight)
+			}
+			if got := s.TakeRight(tt.n); !reflect.DeepEqual(got, NewSlice(tt.wantTakeRight...)) {
+				t.Errorf("s.TakeRight(%d) = %v, want %v", tt.n, got, tt.wantTakeRight)
+			}
+			if got := Drop(input, tt.n); !reflect.DeepEqual(got, tt.wantDrop) {
+				t.Errorf("Drop(%d) = %v, want %v", tt.n, got, tt.wantDrop)
+			}
+			if got := s.Drop(tt.n); !reflect.DeepEqual(got, NewSlice(tt.wantDrop...)) {
+				t.Errorf("s.Drop(%d) = %v, want %v", tt.n, got, tt.wantDrop)
+			}
+			if got := DropRight(input, tt.n); !reflect.DeepEqual(got, tt.wantDropRight) {
+				t.Errorf("DropRight(%d) = %v, want %v", tt.n, got, tt.wantDropRight)
+			}
+			if got := s.DropRight(tt.n); !reflect.DeepEqual(got, NewSlice(tt.wantDropRight...)) {
+				t.Errorf("s.DropRight(%d) = %v, want %v", tt.n, got, tt.wantDropRight)
+			}
+		})
+	}
+
+	t.Run("appending to the result doesn't overwrite the original slice", func(t *testing.T) {
+		original := []int{1, 2, 3, 4}
+		_ = append(Take(original, 2), 99)
+		_ = append(DropRight(original, 2), 99)
+		_ = append(Initial(original[:2]), 99)
+		if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(original, want) {
+			t.Errorf("original = %v, want %v", original, want)
+		}
+	})
+
+	t.Run("the result shares the backing array", func(t *testing.T) {
+		original := []int{1, 2, 3, 4}
+		Drop(original, 2)[0] = 99
+		if original[2] != 99 {
+			t.Errorf("original = %v, want the third element to be 99", original)
+		}
+	})
+}
+
+func TestTakeWhileAndDropWhile(t *testing.T) {
+	isNegative := func(n int) bool { return n < 0 }
+
+	tests := []struct {
+		name          string
+		input         []int
+		wantTakeWhile []int
+		wantDropWhile []int
+	}{
+		{name: "prefix matches", input: []int{-2, -1, 0, -3}, wantTakeWhile: []int{-2, -1}, wantDropWhile: []int{0, -3}},
+		{name: "no element matches", input: []int{1, -1}, wantTakeWhile: []int{}, wantDropWhile: []int{1, -1}},
+		{name: "every element matches", input: []int{-1, -2}, wantTakeWhile: []int{-1, -2}, wantDropWhile: []int{}},
+		{name: "empty slice", input: []int{}, wantTakeWhile: []int{}, wantDropWhile: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TakeWhile(tt.input, isNegative); !reflect.DeepEqual(got, tt.wantTakeWhile) {
+				t.Errorf("TakeWhile(%v) = %v, want %v", tt.input, got, tt.wantTakeWhile)
+			}
+			if got := NewSlice(tt.input...).TakeWhile(isNegative); !reflect.DeepEqual(got, NewSlice(tt.wantTakeWhile...)) {
+				t.Errorf("s.TakeWhile() = %v, want %v", got, tt.wantTakeWhile)
+			}
+			if got := DropWhile(tt.input, isNegative); !reflect.DeepEqual(got, tt.wantDropWhile) {
+				t.Errorf("DropWhile(%v) = %v, want %v", tt.input, got, tt.wantDropWhile)
+			}
+			if got := NewSlice(tt.input...).DropWhile(isNegative); !reflect.DeepEqual(got, NewSlice(tt.wantDropWhile...)) {
+				t.Errorf("s.DropWhile() = %v, want %v", got, tt.wantDropWhile)
+			}
+		})
+	}
+}
+
+func TestInitialAndTail(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []string
+		wantInitial []string
+		wantTail    []string
+	}{
+		{name: "several elements", input: []string{"a", "b", "c"}, wantInitial: []string{"a", "b"}, wantTail: []string{"b", "c"}},
+		{name: "single element", input: []string{"a"}, wantInitial: []string{}, wantTail: []string{}},
+		{name: "empty slice", input: []string{}, wantInitial: []string{}, wantTail: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Initial(tt.input); !reflect.DeepEqual(got, tt.wantInitial) {
+				t.Errorf("Initial(%v) = %v, want %v", tt.input, got, tt.wantInitial)
+			}
+			if got := NewSlice(tt.input...).Initial(); !reflect.DeepEqual(got, NewSlice(tt.wantInitial...)) {
+				t.Errorf("s.Initial() = %v, want %v", got, tt.wantInitial)
+			}
+			if got := Tail(tt.input); !reflect.DeepEqual(got, tt.wantTail) {
+				t.Errorf("Tail(%v) = %v, want %v", tt.input, got, tt.wantTail)
+			}
+			if got := NewSlice(tt.input...).Tail(); !reflect.DeepEqual(got, NewSlice(tt.wantTail...)) {
+				t.Errorf("s.Tail() = %v, want %v", got, tt.wantTail)
+			}
+		})
+	}
+}
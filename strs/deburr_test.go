@@ -0,0 +1,19 @@
+package strs
+
+import "testing"
+
+func TestDeburr(t *testing.T) {
+	tests := map[string]string{
+		"déjà vu":         "deja vu",
+		"Æsir Þór straße": "Aesir Thor strasse",
+		"Łódź Œuvre":      "Lodz Oeuvre",
+		"école":          "ecole",
+		"日本語 and ascii":   "日本語 and ascii",
+	}
+
+	for s, want := range tests {
+		if got := Deburr(s); got != want {
+			t.Errorf("Deburr(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package strs
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/taciogt/godash"
+)
+
+// PadStart returns a mapper that pads strings on the left with chars, repeated and cut as needed, until they are
+// length runes long. Longer strings are kept as they are. If chars is empty, strings are padded with spaces.
+func PadStart(length int, chars string) godash.MustMapper[string, string] {
+	return func(s string) string {
+		return padding(s, length, chars) + s
+	}
+}
+
+// PadEnd returns a mapper that pads strings on the right with chars, repeated and cut as needed, until they are
+// length runes long. Longer strings are kept as they are. If chars is empty, strings are padded with spaces.
+func PadEnd(length int, chars string) godash.MustMapper[string, string] {
+	return func(s string) string {
+		return s + padding(s, length, chars)
+	}
+}
+
+// padding returns the padding that makes s length runes long.
+func padding(s string, length int, chars string) string {
+	if chars == "" {
+		chars = " "
+	}
+	missing := length - utf8.RuneCountInString(s)
+	if missing <= 0 {
+		return ""
+	}
+	repeated := []rune(strings.Repeat(chars, missing/utf8.RuneCountInString(chars)+1))
+	return string(repeated[:missing])
+}
+
+// Truncate returns a mapper that truncates strings longer than length runes, replacing their end with the
+// omission marker, such as "...", so the results are length runes long, marker included.
+// If the marker alone is longer than length, the truncated strings are replaced with the marker.
+func Truncate(length int, omission string) godash.MustMapper[string, string] {
+	return func(s string) string {
+		runes, end, truncated := truncationEnd(s, length, omission)
+		if !truncated {
+			return s
+		}
+		return string(runes[:end]) + omission
+	}
+}
+
+// TruncateWords behaves like [Truncate], except strings are cut at a word boundary, so words aren't split,
+// and the spaces before the omission marker are removed. If the first word is too long, it is cut anyway.
+func TruncateWords(length int, omission string) godash.MustMapper[string, string] {
+	return func(s string) string {
+		runes, end, truncated := truncationEnd(s, length, omission)
+		if !truncated {
+			return s
+		}
+		if end < len(runes) && !unicode.IsSpace(runes[end]) {
+			for boundary := end; boundary > 0; boundary-- {
+				if unicode.IsSpace(runes[boundary-1]) {
+					end = boundary
+					break
+				}
+			}
+		}
+		return strings.TrimRightFunc(string(runes[:end]), unicode.IsSpace) + omission
+	}
+}
+
+// truncationEnd returns the runes of s and how many of them are kept when s is truncated, or false if s doesn't
+// need to be truncated.
+func truncationEnd(s string, length int, omission string) ([]rune, int, bool) {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return runes, len(runes), false
+	}
+	return runes, max(length-utf8.RuneCountInString(omission), 0), true
+}
+
+// Template returns a mapper that replaces the placeholders like ${name} of template strings with the values of
+// the same names, formatted with fmt.Sprint. Placeholders without a value are kept as they are.
+func Template(values map[string]any) godash.MustMapper[string, string] {
+	return func(template string) string {
+		var builder strings.Builder
+		for {
+			start := strings.Index(template, "${")
+			if start < 0 {
+				break
+			}
+			length := strings.IndexByte(template[start:], '}')
+			if length < 0 {
+				break
+			}
+			builder.WriteString(template[:start])
+			placeholder := template[start : start+length+1]
+			if value, ok := values[strings.TrimSpace(placeholder[2:length])]; ok {
+				builder.WriteString(fmt.Sprint(value))
+			} else {
+				builder.WriteString(placeholder)
+			}
+			template = template[start+length+1:]
+		}
+		builder.WriteString(template)
+		return builder.String()
+	}
+}
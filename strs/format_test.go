@@ -0,0 +1,73 @@
+package strs
+
+import "testing"
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		name   string
+		mapper func(string) string
+		s      string
+		want   string
+	}{
+		{"start", PadStart(6, "_-"), "abc", "_-_abc"},
+		{"end", PadEnd(6, "_-"), "abc", "abc_-_"},
+		{"spaces by default", PadStart(4, ""), "ab", "  ab"},
+		{"measured in runes", PadEnd(5, "·"), "né", "né···"},
+		{"multi-rune chars cut", PadStart(4, "ñé"), "a", "ñéña"},
+		{"longer strings", PadStart(2, "x"), "abc", "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mapper(tt.s); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mapper func(string) string
+		s      string
+		want   string
+	}{
+		{"short strings", Truncate(10, "..."), "hello", "hello"},
+		{"exact length", Truncate(5, "..."), "hello", "hello"},
+		{"truncated", Truncate(8, "..."), "hello world", "hello..."},
+		{"measured in runes", Truncate(4, "…"), "ñandú", "ñan…"},
+		{"marker longer than length", Truncate(2, "..."), "hello", "..."},
+		{"empty marker", Truncate(3, ""), "hello", "hel"},
+		{"words", TruncateWords(14, "..."), "hi diddly ho there", "hi diddly..."},
+		{"words cut at a space", TruncateWords(12, "..."), "hi diddly ho there", "hi diddly..."},
+		{"long first word", TruncateWords(6, "..."), "diddly ho", "did..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mapper(tt.s); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	template := Template(map[string]any{"name": "Ana", "count": 3})
+
+	tests := map[string]string{
+		"":                                       "",
+		"Hi ${name}, you have ${count} messages": "Hi Ana, you have 3 messages",
+		"${ name }${name}":                       "AnaAna",
+		"${missing} and ${name}":                 "${missing} and Ana",
+		"unclosed ${name":                        "unclosed ${name",
+		"$name {name}":                           "$name {name}",
+	}
+
+	for s, want := range tests {
+		if got := template(s); got != want {
+			t.Errorf("Template()(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
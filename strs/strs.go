@@ -0,0 +1,146 @@
+/*
+Package strs provides lodash-like string helpers, such as case conversions, padding and truncation.
+
+The functions taking only a string, like [CamelCase], can be passed wherever a godash.MustMapper[string, string]
+is expected, and the functions taking options, like [PadStart], return such a mapper.
+*/
+package strs
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Words splits s into its words, following lodash's words. Words are separated by any character that isn't a
+// letter, a number or an apostrophe within a word, and by changes of case and between letters and numbers.
+// Acronyms are kept together, so "HTTPServer2" has the words "HTTP", "Server" and "2".
+func Words(s string) []string {
+	runes := []rune(s)
+	words := make([]string, 0)
+	start := -1
+	for i, r := range runes {
+		if !isWordRune(runes, i) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && isWordBoundary(runes, i) {
+			words = append(words, string(runes[start:i]))
+			start = -1
+		}
+		if start < 0 && !isApostrophe(r) {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// isWordRune checks whether the rune at index i belongs to a word: letters, numbers and apostrophes between letters.
+func isWordRune(runes []rune, i int) bool {
+	r := runes[i]
+	if isApostrophe(r) {
+		return i > 0 && i+1 < len(runes) && unicode.IsLetter(runes[i-1]) && unicode.IsLetter(runes[i+1])
+	}
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// isWordBoundary checks whether a new word starts at index i, which is preceded by a rune of the same word.
+func isWordBoundary(runes []rune, i int) bool {
+	previous, current := runes[i-1], runes[i]
+	switch {
+	case isApostrophe(previous) || isApostrophe(current):
+		return false
+	case unicode.IsNumber(previous) != unicode.IsNumber(current):
+		return true
+	case unicode.IsUpper(current) && !unicode.IsUpper(previous):
+		return true
+	default:
+		// The last letter of an acronym starts the next word, as in "HTTPServer".
+		return unicode.IsUpper(previous) && unicode.IsUpper(current) &&
+			i+1 < len(runes) && unicode.IsLetter(runes[i+1]) && !unicode.IsUpper(runes[i+1])
+	}
+}
+
+// isApostrophe checks whether r is an ASCII or a typographic apostrophe.
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// compoundWords returns the words used by the case conversions: deburred and without apostrophes, so "Don't"
+// becomes a single word.
+func compoundWords(s string) []string {
+	words := Words(Deburr(s))
+	for i, word := range words {
+		words[i] = strings.Map(func(r rune) rune {
+			if isApostrophe(r) {
+				return -1
+			}
+			return r
+		}, word)
+	}
+	return words
+}
+
+// CamelCase converts s to camel case, like "fooBar". Acronyms are lowered, so "HTTPServer" becomes "httpServer".
+func CamelCase(s string) string {
+	var builder strings.Builder
+	for i, word := range compoundWords(s) {
+		if i == 0 {
+			builder.WriteString(strings.ToLower(word))
+		} else {
+			builder.WriteString(Capitalize(word))
+		}
+	}
+	return builder.String()
+}
+
+// PascalCase converts s to Pascal case, like "FooBar". Acronyms are capitalized, so "HTTPServer" becomes
+// "HttpServer".
+func PascalCase(s string) string {
+	var builder strings.Builder
+	for _, word := range compoundWords(s) {
+		builder.WriteString(Capitalize(word))
+	}
+	return builder.String()
+}
+
+// SnakeCase converts s to snake case, like "foo_bar". "HTTPServer" becomes "http_server".
+func SnakeCase(s string) string {
+	return strings.ToLower(strings.Join(compoundWords(s), "_"))
+}
+
+// KebabCase converts s to kebab case, like "foo-bar". "HTTPServer" becomes "http-server".
+func KebabCase(s string) string {
+	return strings.ToLower(strings.Join(compoundWords(s), "-"))
+}
+
+// StartCase converts s to start case, like "Foo Bar": the first letter of every word is upper cased and the others
+// are kept, so "HTTPServer" becomes "HTTP Server".
+func StartCase(s string) string {
+	words := compoundWords(s)
+	for i, word := range words {
+		words[i] = upperFirst(word)
+	}
+	return strings.Join(words, " ")
+}
+
+// Capitalize converts the first character of s to title case and the remaining ones to lower case,
+// so "hELLO" becomes "Hello".
+func Capitalize(s string) string {
+	return upperFirst(strings.ToLower(s))
+}
+
+// upperFirst converts the first character of s to title case.
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+	return string(unicode.ToTitle(r)) + s[size:]
+}
@@ -0,0 +1,43 @@
+package strs_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+	"github.com/taciogt/godash/strs"
+)
+
+func ExampleSnakeCase() {
+	fields := godash.Slice[string]{"HTTPServer", "userID", "Crème brûlée"}
+
+	fmt.Printf("%q\n", godash.MustMap(fields, strs.SnakeCase))
+	// Output:
+	// ["http_server" "user_id" "creme_brulee"]
+}
+
+func ExampleWords() {
+	fmt.Printf("%q\n", strs.Words("XMLHttpRequest, don't retry2"))
+	// Output:
+	// ["XML" "Http" "Request" "don't" "retry" "2"]
+}
+
+func ExamplePadStart() {
+	ids := godash.Slice[string]{"7", "42", "1234"}
+
+	fmt.Println(godash.MustMap(ids, strs.PadStart(3, "0")))
+	// Output:
+	// [007 042 1234]
+}
+
+func ExampleTruncateWords() {
+	fmt.Println(strs.TruncateWords(20, "…")("The quick brown fox jumps over the lazy dog"))
+	// Output:
+	// The quick brown fox…
+}
+
+func ExampleTemplate() {
+	greet := strs.Template(map[string]any{"name": "Ana", "count": 3})
+
+	fmt.Println(greet("Hi ${name}, you have ${count} new messages"))
+	// Output:
+	// Hi Ana, you have 3 new messages
+}
@@ -0,0 +1,83 @@
+package strs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"", []string{}},
+		{"fooBar", []string{"foo", "Bar"}},
+		{"__FOO_BAR__", []string{"FOO", "BAR"}},
+		{"HTTPServer", []string{"HTTP", "Server"}},
+		{"XMLHttpRequest2", []string{"XML", "Http", "Request", "2"}},
+		{"don't stop", []string{"don't", "stop"}},
+		{"'quoted' words", []string{"quoted", "words"}},
+		{"élan vital", []string{"élan", "vital"}},
+		{"日本語 テキスト", []string{"日本語", "テキスト"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := Words(tt.s); !slices.Equal(got, tt.want) {
+				t.Errorf("Words(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCaseConversions(t *testing.T) {
+	tests := []struct {
+		s                                      string
+		camel, pascal, snake, kebab, startCase string
+	}{
+		{"foo bar", "fooBar", "FooBar", "foo_bar", "foo-bar", "Foo Bar"},
+		{"--FOO-BAR--", "fooBar", "FooBar", "foo_bar", "foo-bar", "FOO BAR"},
+		{"HTTPServer", "httpServer", "HttpServer", "http_server", "http-server", "HTTP Server"},
+		{"userID2", "userId2", "UserId2", "user_id_2", "user-id-2", "User ID 2"},
+		{"Don't panic", "dontPanic", "DontPanic", "dont_panic", "dont-panic", "Dont Panic"},
+		{"Crème brûlée", "cremeBrulee", "CremeBrulee", "creme_brulee", "creme-brulee", "Creme Brulee"},
+		{"Ελληνικά γράμματα", "ελληνικάΓράμματα", "ΕλληνικάΓράμματα", "ελληνικά_γράμματα", "ελληνικά-γράμματα", "Ελληνικά Γράμματα"},
+		{"", "", "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			for _, c := range []struct {
+				name    string
+				convert func(string) string
+				want    string
+			}{
+				{"CamelCase", CamelCase, tt.camel},
+				{"PascalCase", PascalCase, tt.pascal},
+				{"SnakeCase", SnakeCase, tt.snake},
+				{"KebabCase", KebabCase, tt.kebab},
+				{"StartCase", StartCase, tt.startCase},
+			} {
+				if got := c.convert(tt.s); got != c.want {
+					t.Errorf("%s(%q) = %q, want %q", c.name, tt.s, got, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCapitalize(t *testing.T) {
+	tests := map[string]string{
+		"":      "",
+		"hELLO": "Hello",
+		"élan":  "Élan",
+		"ǆemal": "ǅemal",
+		"1st":   "1st",
+	}
+
+	for s, want := range tests {
+		if got := Capitalize(s); got != want {
+			t.Errorf("Capitalize(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package godash
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// TrieOptions configures a [Trie].
+type TrieOptions struct {
+	// Compact stores the trie as a radix tree, where a chain of nodes without other branches is merged into a
+	// single node, using less memory for long keys with few shared prefixes.
+	Compact bool
+}
+
+// Trie is a prefix tree mapping string keys to values, to look up keys by their prefixes, such as for
+// autocompletion or routing. Keys are compared rune by rune, so a prefix never ends in the middle of a rune,
+// and keys are visited in sorted order when they are valid UTF-8.
+//
+// The zero value is an empty trie that isn't compact. A Trie isn't safe for concurrent use.
+type Trie[V any] struct {
+	root    trieNode[V]
+	len     int
+	compact bool
+}
+
+// trieNode is a node of a [Trie]. Its key is the concatenation of the labels from the root to the node.
+type trieNode[V any] struct {
+	label    string         // a single rune, unless the trie is compact
+	children []*trieNode[V] // sorted by the first rune of their labels, which are all different
+	value    V
+	hasValue bool
+}
+
+// NewTrie creates an empty Trie configured by the options.
+func NewTrie[V any](options TrieOptions) *Trie[V] {
+	return &Trie[V]{compact: options.Compact}
+}
+
+// TrieFromSet creates a Trie configured by the options, holding the elements of the set as keys.
+func TrieFromSet(set Set[string], options TrieOptions) *Trie[struct{}] {
+	t := NewTrie[struct{}](options)
+	for key := range set {
+		t.Insert(key, struct{}{})
+	}
+	return t
+}
+
+// Len returns the number of keys of the trie.
+func (t *Trie[V]) Len() int {
+	return t.len
+}
+
+// Insert maps the key to the value, replacing the value the key already had.
+func (t *Trie[V]) Insert(key string, value V) {
+	node := &t.root
+	for rest := key; rest != ""; {
+		i, found := node.child(rest)
+		if !found {
+			head, tail := t.branch(rest)
+			node.children = slices.Insert(node.children, i, head)
+			node = tail
+			break
+		}
+
+		child := node.children[i]
+		n := commonPrefix(rest, child.label)
+		if n < len(child.label) {
+			// Only compact tries have longer labels, which are split where the key branches off.
+			parent := &trieNode[V]{label: child.label[:n], children: []*trieNode[V]{child}}
+			child.label = child.label[n:]
+			node.children[i] = parent
+			child = parent
+		}
+		node, rest = child, rest[n:]
+	}
+
+	if !node.hasValue {
+		t.len++
+	}
+	node.value, node.hasValue = value, true
+}
+
+// Get returns the value of the key. If the key isn't in the trie, it returns the zero value of type `V` and
+// `false`.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	node := &t.root
+	for key != "" {
+		i, found := node.child(key)
+		if !found || commonPrefix(key, node.children[i].label) != len(node.children[i].label) {
+			var zero V
+			return zero, false
+		}
+		node, key = node.children[i], key[len(node.children[i].label):]
+	}
+	return node.value, node.hasValue
+}
+
+// Delete removes the key from the trie, and returns whether it was in the trie.
+func (t *Trie[V]) Delete(key string) bool {
+	if !t.root.delete(key, t.compact) {
+		return false
+	}
+	t.len--
+	return true
+}
+
+// HasPrefix checks whether any key of the trie starts with the prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	node, _ := t.locate(prefix)
+	return node != nil && (node.hasValue || len(node.children) > 0)
+}
+
+// KeysWithPrefix returns the keys of the trie starting with the prefix, in sorted order.
+func (t *Trie[V]) KeysWithPrefix(prefix string) Slice[string] {
+	keys := Slice[string]{}
+	if node, before := t.locate(prefix); node != nil {
+		node.walk([]byte(before), func(key string, _ V) bool {
+			keys = append(keys, key)
+			return true
+		})
+	}
+	return keys
+}
+
+// LongestPrefixOf returns the longest key of the trie that is a prefix of s, and its value. If no key is a
+// prefix of s, it returns `false`.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	node, rest := &t.root, s
+	var longest *trieNode[V]
+	length := 0
+	if node.hasValue {
+		longest = node
+	}
+	for rest != "" {
+		i, found := node.child(rest)
+		if !found || commonPrefix(rest, node.children[i].label) != len(node.children[i].label) {
+			break
+		}
+		node, rest = node.children[i], rest[len(node.children[i].label):]
+		if node.hasValue {
+			longest, length = node, len(s)-len(rest)
+		}
+	}
+
+	if longest == nil {
+		var zero V
+		return "", zero, false
+	}
+	return s[:length], longest.value, true
+}
+
+// Walk calls f with every key of the trie and its value, in sorted order, until f returns false.
+func (t *Trie[V]) Walk(f func(key string, value V) bool) {
+	t.root.walk(nil, f)
+}
+
+// ToSet returns the keys of the trie in a new Set.
+func (t *Trie[V]) ToSet() Set[string] {
+	set := NewSet[string]()
+	t.Walk(func(key string, _ V) bool {
+		set.Add(key)
+		return true
+	})
+	return set
+}
+
+// branch creates the nodes holding the key below an existing node: a single node if the trie is compact, or a
+// node per rune otherwise.
+func (t *Trie[V]) branch(key string) (head, tail *trieNode[V]) {
+	if t.compact {
+		node := &trieNode[V]{label: key}
+		return node, node
+	}
+	for key != "" {
+		node := &trieNode[V]{label: firstRune(key)}
+		if head == nil {
+			head = node
+		} else {
+			tail.children = []*trieNode[V]{node}
+		}
+		tail, key = node, key[len(node.label):]
+	}
+	return head, tail
+}
+
+// locate returns the node whose descendants, including itself, are the keys starting with the prefix, along
+// with the key up to the node, excluding its label. It returns nil if no key starts with the prefix.
+func (t *Trie[V]) locate(prefix string) (node *trieNode[V], before string) {
+	node = &t.root
+	for rest := prefix; rest != ""; {
+		i, found := node.child(rest)
+		if !found {
+			return nil, ""
+		}
+		child := node.children[i]
+		n := commonPrefix(rest, child.label)
+		if n < len(rest) && n < len(child.label) {
+			return nil, ""
+		}
+		before = prefix[:len(prefix)-len(rest)]
+		node, rest = child, rest[n:]
+	}
+	return node, before
+}
+
+// child returns the position of the child whose label starts with the same rune as the key, or the position
+// where it would be inserted.
+func (n *trieNode[V]) child(key string) (int, bool) {
+	return slices.BinarySearchFunc(n.children, firstRune(key), func(child *trieNode[V], r string) int {
+		return strings.Compare(firstRune(child.label), r)
+	})
+}
+
+// delete removes the key, relative to the node, from the descendants of the node, removing the nodes left
+// without keys, and merging the nodes left with a single child if the trie is compact.
+func (n *trieNode[V]) delete(key string, compact bool) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		var zero V
+		n.value, n.hasValue = zero, false
+		return true
+	}
+
+	i, found := n.child(key)
+	if !found {
+		return false
+	}
+	child := n.children[i]
+	if commonPrefix(key, child.label) != len(child.label) || !child.delete(key[len(child.label):], compact) {
+		return false
+	}
+	switch {
+	case !child.hasValue && len(child.children) == 0:
+		n.children = slices.Delete(n.children, i, i+1)
+	case compact && !child.hasValue && len(child.children) == 1:
+		grandchild := child.children[0]
+		grandchild.label = child.label + grandchild.label
+		n.children[i] = grandchild
+	}
+	return true
+}
+
+// walk calls f with the keys of the node and its descendants, where key is the key up to the node, excluding
+// its label. It returns false if f did.
+func (n *trieNode[V]) walk(key []byte, f func(key string, value V) bool) bool {
+	key = append(key, n.label...)
+	if n.hasValue && !f(string(key), n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(key, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// firstRune returns the bytes of the first rune of s, which is a single byte for invalid UTF-8.
+func firstRune(s string) string {
+	_, size := utf8.DecodeRuneInString(s)
+	return s[:size]
+}
+
+// commonPrefix returns the length in bytes of the longest common prefix of a and b made of whole runes.
+func commonPrefix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) {
+		_, size := utf8.DecodeRuneInString(a[n:])
+		if size > len(b)-n || a[n:n+size] != b[n:n+size] {
+			break
+		}
+		if _, other := utf8.DecodeRuneInString(b[n:]); other != size {
+			break
+		}
+		n += size
+	}
+	return n
+}
@@ -0,0 +1,21 @@
+package godash_test
+
+import (
+	"fmt"
+	"github.com/taciogt/godash"
+)
+
+func ExampleTrie() {
+	routes := godash.NewTrie[string](godash.TrieOptions{Compact: true})
+	routes.Insert("/api", "api")
+	routes.Insert("/api/users", "users")
+	routes.Insert("/api/orders", "orders")
+
+	fmt.Println(routes.KeysWithPrefix("/api/"))
+	fmt.Println(routes.LongestPrefixOf("/api/users/42"))
+	fmt.Println(routes.HasPrefix("/web"))
+	// Output:
+	// [/api/orders /api/users]
+	// /api/users users true
+	// false
+}
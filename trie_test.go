@@ -0,0 +1,218 @@
+package godash
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var trieModes = map[string]TrieOptions{"trie": {}, "compact": {Compact: true}}
+
+func TestTrie(t *testing.T) {
+	for name, options := range trieModes {
+		t.Run(name, func(t *testing.T) {
+			trie := NewTrie[int](options)
+			for i, key := range []string{"car", "cart", "care", "cat", "", "dog", "日本", "日本語"} {
+				trie.Insert(key, i)
+			}
+			trie.Insert("cat", 10)
+
+			if got, ok := trie.Get("cat"); !ok || got != 10 || trie.Len() != 8 {
+				t.Errorf("Get(cat) = %d, %t and Len() = %d, want 10, true and 8", got, ok, trie.Len())
+			}
+			for _, key := range []string{"ca", "cars", "日", "d"} {
+				if _, ok := trie.Get(key); ok {
+					t.Errorf("Get(%q) found a key that wasn't inserted", key)
+				}
+			}
+
+			prefixes := map[string][]string{
+				"car":  {"car", "care", "cart"},
+				"ca":   {"car", "care", "cart", "cat"},
+				"日":    {"日本", "日本語"},
+				"x":    {},
+				"\xe6": {},
+			}
+			for prefix, want := range prefixes {
+				if got := trie.KeysWithPrefix(prefix); !slices.Equal(got, want) {
+					t.Errorf("KeysWithPrefix(%q) = %q, want %q", prefix, got, want)
+				}
+				if got := trie.HasPrefix(prefix); got != (len(want) > 0) {
+					t.Errorf("HasPrefix(%q) = %t", prefix, got)
+				}
+			}
+			if got := trie.KeysWithPrefix(""); len(got) != 8 || got[0] != "" {
+				t.Errorf("KeysWithPrefix(\"\") = %q, want every key", got)
+			}
+
+			longest := map[string]string{"cartoon": "cart", "ca": "", "日本人": "日本", "dog": "dog"}
+			for s, want := range longest {
+				if got, _, ok := trie.LongestPrefixOf(s); !ok || got != want {
+					t.Errorf("LongestPrefixOf(%q) = %q, %t, want %q, true", s, got, ok, want)
+				}
+			}
+
+			if trie.Delete("ca") || !trie.Delete("") || !trie.Delete("car") || trie.Delete("car") {
+				t.Error("Delete() didn't report the deleted keys")
+			}
+			if _, _, ok := trie.LongestPrefixOf("ca"); ok {
+				t.Error("LongestPrefixOf(\"ca\") found a deleted key")
+			}
+			want := NewSet("cart", "care", "cat", "dog", "日本", "日本語")
+			if got := trie.ToSet(); !maps.Equal(got, want) {
+				t.Errorf("ToSet() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTrie_ZeroValue(t *testing.T) {
+	var trie Trie[string]
+	if trie.HasPrefix("") || trie.Len() != 0 || len(trie.KeysWithPrefix("")) != 0 {
+		t.Error("the zero value isn't an empty trie")
+	}
+	trie.Insert("a", "b")
+	if got, ok := trie.Get("a"); !ok || got != "b" {
+		t.Errorf("Get(a) = %q, %t, want b, true", got, ok)
+	}
+}
+
+func TestTrie_Walk(t *testing.T) {
+	trie := TrieFromSet(NewSet("b", "a", "c", "ab"), TrieOptions{})
+	var keys []string
+	trie.Walk(func(key string, _ struct{}) bool {
+		keys = append(keys, key)
+		return key != "b"
+	})
+	if !slices.Equal(keys, []string{"a", "ab", "b"}) {
+		t.Errorf("Walk() visited %q, want [a ab b]", keys)
+	}
+}
+
+// checkCompact fails the test if a node of a compact trie could be merged with its only child.
+func checkCompact[V any](t *testing.T, node *trieNode[V], root bool) {
+	t.Helper()
+	if !root && !node.hasValue && len(node.children) < 2 {
+		t.Fatalf("the node %q has %d children and no value", node.label, len(node.children))
+	}
+	for _, child := range node.children {
+		checkCompact(t, child, false)
+	}
+}
+
+func TestTrie_Random(t *testing.T) {
+	// Random operations on tries are checked against the same operations on a map.
+	runes := []rune("abcé日本\U0001F600")
+	randomKey := func(r *rand.Rand) string {
+		var b strings.Builder
+		for n := r.IntN(6); n > 0; n-- {
+			b.WriteRune(runes[r.IntN(len(runes))])
+		}
+		return b.String()
+	}
+
+	for name, options := range trieModes {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewPCG(7, 8))
+			trie := NewTrie[int](options)
+			model := map[string]int{}
+
+			for step := 0; step < 5000; step++ {
+				key := randomKey(r)
+				if r.IntN(3) == 0 {
+					_, ok := model[key]
+					delete(model, key)
+					if trie.Delete(key) != ok {
+						t.Fatalf("Delete(%q) != %t", key, ok)
+					}
+				} else {
+					model[key] = step
+					trie.Insert(key, step)
+				}
+
+				prefix := randomKey(r)
+				var want []string
+				for k := range model {
+					if strings.HasPrefix(k, prefix) {
+						want = append(want, k)
+					}
+				}
+				slices.Sort(want)
+				if got := trie.KeysWithPrefix(prefix); !slices.Equal(got, want) || trie.Len() != len(model) {
+					t.Fatalf("KeysWithPrefix(%q) = %q, want %q", prefix, got, want)
+				}
+				if got, ok := trie.Get(prefix); got != model[prefix] || ok != (len(want) > 0 && want[0] == prefix) {
+					t.Fatalf("Get(%q) = %d, %t", prefix, got, ok)
+				}
+			}
+			if options.Compact {
+				checkCompact(t, &trie.root, true)
+			}
+		})
+	}
+}
+
+func benchmarkKeys() Slice[string] {
+	r := rand.New(rand.NewPCG(1, 1))
+	keys := make(Slice[string], 10_000)
+	for i := range keys {
+		b := make([]byte, 3+r.IntN(8))
+		for j := range b {
+			b[j] = byte('a' + r.IntN(26))
+		}
+		keys[i] = string(b)
+	}
+	return keys
+}
+
+func BenchmarkTrie_KeysWithPrefix(b *testing.B) {
+	keys := benchmarkKeys()
+	set := NewSet(keys...)
+	for name, options := range trieModes {
+		trie := TrieFromSet(set, options)
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				trie.KeysWithPrefix(keys[i%len(keys)][:2])
+			}
+		})
+	}
+	b.Run("set", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			prefix := keys[i%len(keys)][:2]
+			found := Slice[string]{}
+			for key := range set {
+				if strings.HasPrefix(key, prefix) {
+					found = append(found, key)
+				}
+			}
+			slices.Sort(found)
+		}
+	})
+}
+
+func BenchmarkTrie_HasPrefix(b *testing.B) {
+	keys := benchmarkKeys()
+	set := NewSet(keys...)
+	for name, options := range trieModes {
+		trie := TrieFromSet(set, options)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				trie.HasPrefix(keys[i%len(keys)][:3])
+			}
+		})
+	}
+	b.Run("set", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			prefix := keys[i%len(keys)][:3]
+			for key := range set {
+				if strings.HasPrefix(key, prefix) {
+					break
+				}
+			}
+		}
+	})
+}